@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/asg"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -20,12 +21,6 @@ var (
 
 	showARNs bool
 
-	sortName            bool
-	sortInstances       bool
-	sortDesiredCapacity bool
-	sortMinCapacity     bool
-	sortMaxCapacity     bool
-
 	reverseSort bool
 )
 
@@ -39,18 +34,18 @@ func init() {
 
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Name", Category: "Auto Scaling Group", Visible: true, DefaultSort: true, SortBy: sortName, SortDirection: tablewriter.Asc},
-		{Name: "Instances", Category: "Auto Scaling Group", Visible: true, SortBy: sortInstances, SortDirection: tablewriter.Desc},
-		{Name: "Desired", Category: "Auto Scaling Group", Visible: true, SortBy: sortDesiredCapacity, SortDirection: tablewriter.Desc},
-		{Name: "Min", Category: "Auto Scaling Group", Visible: true, SortBy: sortMinCapacity, SortDirection: tablewriter.Desc},
-		{Name: "Max", Category: "Auto Scaling Group", Visible: true, SortBy: sortMaxCapacity, SortDirection: tablewriter.Desc},
+		{Name: "Name", Category: "Auto Scaling Group", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
+		{Name: "Instances", Category: "Auto Scaling Group", Visible: true},
+		{Name: "Desired", Category: "Auto Scaling Group", Visible: true},
+		{Name: "Min", Category: "Auto Scaling Group", Visible: true},
+		{Name: "Max", Category: "Auto Scaling Group", Visible: true},
 		{Name: "ARN", Category: "Auto Scaling Group", Visible: showARNs},
 	}
 }
 
 func getInstanceFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Name", Category: "Instance", Visible: true, SortBy: sortName, SortDirection: tablewriter.Asc},
+		{Name: "Name", Category: "Instance", Visible: true},
 		{Name: "State", Category: "Instance", Visible: true},
 		{Name: "Instance Type", Category: "Instance", Visible: true},
 		{Name: "Launch Template/Configuration", Category: "Instance", Visible: true},
@@ -70,17 +65,22 @@ var lsCmd = &cobra.Command{
 	RunE: func(cobraCmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ListAutoScalingGroups(cobraCmd, args))
 	},
+	ValidArgsFunction: completion.GroupNames(1),
 }
 
 // newLsFlags is the function for adding flags to the ls command
 func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs Auto-Scaling Groups in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields, getInstanceFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields, getInstanceFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields, getInstanceFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&showARNs, "arn", "a", false, "Show ARNs for each Auto-Scaling Group.")
-	cobraCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by descending ASG name.")
-	cobraCmd.Flags().BoolVarP(&sortInstances, "sort-instances", "i", false, "Sort by descending number of instances. (ASG output only)")
-	cobraCmd.Flags().BoolVarP(&sortDesiredCapacity, "sort-desired-capacity", "d", false, "Sort by descending desired capacity. (ASG output only)")
-	cobraCmd.Flags().BoolVarP(&sortMinCapacity, "sort-min-capacity", "m", false, "Sort by descending min capacity. (ASG output only)")
-	cobraCmd.Flags().BoolVarP(&sortMaxCapacity, "sort-max-capacity", "M", false, "Sort by descending max capacity. (ASG output only)")
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "Name")
+	cmdutil.AddSortAlias(cobraCmd, "sort-instances", "i", "Instances:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-desired-capacity", "d", "Desired:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-min-capacity", "m", "Min:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-max-capacity", "M", "Max:desc")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
 }
 
@@ -89,32 +89,40 @@ func newLsFlags(cobraCmd *cobra.Command) {
 //
 
 func ListAutoScalingGroups(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, asg.NewAutoScalingService)
-	if err != nil {
-		return fmt.Errorf("create new Auto Scaling Group service: %w", err)
-	}
-
 	if len(args) > 0 {
+		svc, err := cmdutil.CreateService(cmd, asg.NewAutoScalingService)
+		if err != nil {
+			return fmt.Errorf("create new Auto Scaling Group service: %w", err)
+		}
+
 		fmt.Printf("Listing instances for Auto Scaling Group %s\n", args[0])
 		return ListAutoScalingGroupInstances(cmd.Context(), svc, args[0])
-	} else {
-		autoScalingGroups, err := svc.GetAutoScalingGroups(cmd.Context(), &ascTypes.GetAutoScalingGroupsInput{})
+	}
+
+	results, err := cmdutil.FanOut(cmd, asg.NewAutoScalingService, func(ctx context.Context, svc *asg.AutoScalingService) ([]any, error) {
+		autoScalingGroups, err := svc.GetAutoScalingGroups(ctx, &ascTypes.GetAutoScalingGroupsInput{})
 		if err != nil {
-			return fmt.Errorf("get Auto Scaling Groups: %w", err)
+			return nil, fmt.Errorf("get Auto Scaling Groups: %w", err)
 		}
-
-		tablewriter.RenderList(tablewriter.RenderListOptions{
-			Title:         "Auto Scaling Groups",
-			PlainStyle:    list,
-			Fields:        getListFields(),
-			Tags:          cmdutil.Tags,
-			Data:          utils.SlicesToAny(autoScalingGroups),
-			GetFieldValue: asg.GetFieldValue,
-			GetTagValue:   asg.GetTagValue,
-			ReverseSort:   reverseSort,
-		})
-		return nil
+		return utils.SlicesToAny(autoScalingGroups), nil
+	})
+	if err != nil {
+		return err
 	}
+
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
+		Title:         "Auto Scaling Groups",
+		PlainStyle:    list,
+		Fields:        results.Fields(getListFields()),
+		Tags:          cmdutil.Tags,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(asg.GetFieldValue),
+		GetTagValue:   results.TagGetter(asg.GetTagValue),
+		ReverseSort:   reverseSort,
+	})
 }
 
 // ListAutoScalingGroupInstances is the function for listing instances in an Auto Scaling Group
@@ -129,14 +137,16 @@ func ListAutoScalingGroupInstances(ctx context.Context, svc *asg.AutoScalingServ
 		return fmt.Errorf("get instances for Auto Scaling Group %s: %w", asgName, err)
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Auto Scaling Group Instances",
 		PlainStyle:    list,
 		Fields:        getInstanceFields(),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(instances),
 		GetFieldValue: asg.GetFieldValue,
 		GetTagValue:   asg.GetTagValue,
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
@@ -5,8 +5,13 @@ package asg
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/asg"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -25,6 +30,7 @@ var (
 
 // Init function
 func init() {
+	cmdutil.AllowDryRun(modifyCmd)
 	newModifyFlags(modifyCmd)
 }
 
@@ -55,6 +61,7 @@ var modifyCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ModifyAutoScalingGroup(cmd, args))
 	},
+	ValidArgsFunction: completion.GroupNames(1),
 }
 
 // Flag function
@@ -121,11 +128,16 @@ func ModifyAutoScalingGroup(cmd *cobra.Command, args []string) error {
 		input.DesiredCapacity = &desiredCapacityInt32
 	}
 
+	if cmdutil.IsDryRun(cmd) {
+		return renderModifyPlan(asgOutput[0], input)
+	}
+
 	// Modify the Auto Scaling Group
 	err = svc.ModifyAutoScalingGroup(ctx, input)
 	if err != nil {
 		return fmt.Errorf("modify Auto Scaling Group: %w", err)
 	}
+	cmdutil.RecordChange(cmd, "asg://auto-scaling-group/"+args[0], "asg/modify", asg.CapacityState(asgOutput[0]))
 
 	// Add a schedule to revert the change after a given duration
 	if durationStr != "" {
@@ -164,6 +176,40 @@ func ModifyAutoScalingGroup(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// renderModifyPlan renders the capacity changes that would be made to the Auto Scaling Group,
+// and the time they would be reverted if --duration is set.
+func renderModifyPlan(group types.AutoScalingGroup, input *ascTypes.ModifyAutoScalingGroupInput) error {
+	var changes []cmdutil.Change
+	addChange := func(field string, before *int32, after *int32) {
+		if after != nil {
+			changes = append(changes, cmdutil.Change{
+				Resource: input.AutoScalingGroupName,
+				Field:    field,
+				Before:   strconv.Itoa(int(aws.ToInt32(before))),
+				After:    strconv.Itoa(int(*after)),
+			})
+		}
+	}
+	addChange("Min", group.MinSize, input.MinSize)
+	addChange("Max", group.MaxSize, input.MaxSize)
+	addChange("Desired Capacity", group.DesiredCapacity, input.DesiredCapacity)
+
+	if durationStr != "" {
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return fmt.Errorf("parse duration: %w", err)
+		}
+		changes = append(changes, cmdutil.Change{
+			Resource: input.AutoScalingGroupName,
+			Field:    "Revert Schedule",
+			After:    time.Now().Add(duration).Format("Monday January 2 2006 at 15:04:05"),
+		})
+	}
+
+	cmdutil.RenderPlan(changes)
+	return nil
+}
+
 func addRevertSchedule(ctx context.Context, svc *asg.AutoScalingService, input *ascTypes.AddAutoScalingGroupScheduleInput) error {
 	err := svc.AddAutoScalingGroupSchedule(ctx, input)
 	if err != nil {
@@ -178,12 +224,11 @@ func addRevertSchedule(ctx context.Context, svc *asg.AutoScalingService, input *
 		return fmt.Errorf("get scheduled revert action: %w", err)
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Scheduled Revert Action",
 		Fields:        asgScheduleFields(),
 		Data:          utils.SlicesToAny(schedules),
 		GetFieldValue: asg.GetFieldValue,
 		GetTagValue:   asg.GetTagValue,
 	})
-	return nil
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/asg"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -38,8 +40,10 @@ var addCmd = &cobra.Command{
 
 // NewAddFlags adds flags for the add subcommand.
 func NewAddFlags(cobraCmd *cobra.Command) {
+	cmdutil.AllowDryRun(cobraCmd)
 	cobraCmd.Flags().StringVarP(&asgName, "asg-name", "a", "", "The name of the Auto Scaling Group to add the schedule to.")
 	cobraCmd.MarkFlagRequired("asg-name")
+	completion.RegisterFlag(cobraCmd, "asg-name", completion.GroupNameFlag())
 	cobraCmd.Flags().IntVarP(&minSize, "min-size", "m", -1, "The minimum size of the Auto Scaling Group.")
 	cobraCmd.Flags().IntVarP(&maxSize, "max-size", "M", -1, "The maximum size of the Auto Scaling Group.")
 	cobraCmd.Flags().IntVarP(&desiredCapacity, "desired-capacity", "d", -1, "The desired capacity of the Auto Scaling Group.")
@@ -119,10 +123,28 @@ func AddSchedule(cmd *cobra.Command, args []string) error {
 		input.EndTime = endTime
 	}
 
+	existing, err := getSchedule(ctx, svc, asgName, scheduledActionName)
+	if err != nil {
+		return err
+	}
+
+	if cmdutil.IsDryRun(cmd) {
+		renderSchedulePlan(scheduledActionName, existing, &types.ScheduledUpdateGroupAction{
+			Recurrence:      input.Recurrence,
+			StartTime:       input.StartTime,
+			EndTime:         input.EndTime,
+			DesiredCapacity: input.DesiredCapacity,
+			MinSize:         input.MinSize,
+			MaxSize:         input.MaxSize,
+		})
+		return nil
+	}
+
 	err = svc.AddAutoScalingGroupSchedule(ctx, input)
 	if err != nil {
 		return fmt.Errorf("add schedule: %w", err)
 	}
+	recordScheduleChange(cmd, asgName, scheduledActionName, existing)
 
 	// List the schedule and print it
 	schedules, err := svc.GetAutoScalingGroupSchedules(
@@ -136,12 +158,11 @@ func AddSchedule(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("get schedule: %w", err)
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Scheduled Actions",
 		Fields:        getScheduleFields(),
 		Data:          utils.SlicesToAny(schedules),
 		GetFieldValue: asg.GetScheduleAttributeValue,
 		GetTagValue:   asg.GetTagValue,
 	})
-	return nil
 }
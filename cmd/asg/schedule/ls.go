@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/asg"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -14,13 +15,7 @@ import (
 )
 
 var (
-	list                bool
-	sortName            bool
-	sortStartTime       bool
-	sortEndTime         bool
-	sortDesiredCapacity bool
-	sortMinSize         bool
-	sortMaxSize         bool
+	list bool
 
 	reverseSort bool
 )
@@ -33,13 +28,13 @@ func init() {
 func getScheduleFields() []tablewriter.Field {
 	return []tablewriter.Field{
 		{Name: "Auto Scaling Group", Category: "Schedule", Visible: true},
-		{Name: "Name", Category: "Schedule", Visible: true, SortBy: sortName, SortDirection: tablewriter.Asc},
+		{Name: "Name", Category: "Schedule", Visible: true},
 		{Name: "Recurrence", Category: "Schedule", Visible: true},
-		{Name: "Start Time", Category: "Schedule", Visible: true, DefaultSort: true, SortBy: sortStartTime, SortDirection: tablewriter.Desc},
-		{Name: "End Time", Category: "Schedule", Visible: true, SortBy: sortEndTime, SortDirection: tablewriter.Desc},
-		{Name: "Desired Capacity", Category: "Schedule", Visible: true, SortBy: sortDesiredCapacity, SortDirection: tablewriter.Desc},
-		{Name: "Min", Category: "Schedule", Visible: true, SortBy: sortMinSize, SortDirection: tablewriter.Desc},
-		{Name: "Max", Category: "Schedule", Visible: true, SortBy: sortMaxSize, SortDirection: tablewriter.Desc},
+		{Name: "Start Time", Category: "Schedule", Visible: true, DefaultSort: true, SortDirection: tablewriter.Desc},
+		{Name: "End Time", Category: "Schedule", Visible: true},
+		{Name: "Desired Capacity", Category: "Schedule", Visible: true},
+		{Name: "Min", Category: "Schedule", Visible: true},
+		{Name: "Max", Category: "Schedule", Visible: true},
 	}
 }
 
@@ -51,23 +46,23 @@ var lsCmd = &cobra.Command{
 	RunE: func(cobraCmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ListSchedules(cobraCmd, args))
 	},
+	ValidArgsFunction: completion.GroupNames(1),
 }
 
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().
 		BoolVarP(&list, "list", "l", false, "Outputs Auto-Scaling Groups in list format.")
-	cobraCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by descending ASG name.")
-	cobraCmd.Flags().
-		BoolVarP(&sortStartTime, "sort-start-time", "t", false, "Sort by descending start time (most recently started first).")
-	cobraCmd.Flags().
-		BoolVarP(&sortEndTime, "sort-end-time", "e", false, "Sort by descending end time (most recently ended first).")
-	cobraCmd.Flags().
-		BoolVarP(&sortDesiredCapacity, "sort-desired-capacity", "d", false, "Sort by descending desired capacity (most frequent first).")
-	cobraCmd.Flags().
-		BoolVarP(&sortMinSize, "sort-min-size", "m", false, "Sort by descending min size (most frequent first).")
-	cobraCmd.Flags().
-		BoolVarP(&sortMaxSize, "sort-max-size", "M", false, "Sort by descending max size (most frequent first).")
+	cmdutil.AddColumnsFlag(cobraCmd, getScheduleFields)
+	cmdutil.AddSortFlag(cobraCmd, getScheduleFields)
+	cmdutil.AddFilterFlag(cobraCmd, getScheduleFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "Name")
+	cmdutil.AddSortAlias(cobraCmd, "sort-start-time", "t", "Start Time:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-end-time", "e", "End Time:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-desired-capacity", "d", "Desired Capacity:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-min-size", "m", "Min:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-max-size", "M", "Max:desc")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
 	cobraCmd.MarkFlagsMutuallyExclusive(
 		"sort-name",
@@ -85,17 +80,14 @@ func NewLsFlags(cobraCmd *cobra.Command) {
 
 // ListSchedules is the handler for the ls subcommand.
 func ListSchedules(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, asg.NewAutoScalingService)
-	if err != nil {
-		return fmt.Errorf("create new Auto Scaling Group service: %w", err)
-	}
-
-	ctx := cmd.Context()
 	if len(args) > 0 {
-		return ListSchedulesForGroup(ctx, svc, args[0])
-	} else {
-		return ListSchedulesForAllGroups(ctx, svc)
+		svc, err := cmdutil.CreateService(cmd, asg.NewAutoScalingService)
+		if err != nil {
+			return fmt.Errorf("create new Auto Scaling Group service: %w", err)
+		}
+		return ListSchedulesForGroup(cmd.Context(), svc, args[0])
 	}
+	return ListSchedulesForAllGroups(cmd)
 }
 
 // ListSchedulesForGroup lists all schedules for a given Auto Scaling Group.
@@ -114,36 +106,46 @@ func ListSchedulesForGroup(ctx context.Context, svc *asg.AutoScalingService, asg
 	// Hide "Auto Scaling Group" field when listing for a single group
 	tablewriter.SetFieldVisibility(fields, "Auto Scaling Group", false)
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         fmt.Sprintf("Scheduled Actions\n(%s)", asgName),
 		PlainStyle:    list,
 		Fields:        fields,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(schedules),
 		GetFieldValue: asg.GetFieldValue,
 		GetTagValue:   asg.GetTagValue,
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
 
 // ListSchedulesForAllGroups lists all schedules for all Auto Scaling Groups.
-func ListSchedulesForAllGroups(ctx context.Context, svc *asg.AutoScalingService) error {
-	schedules, err := svc.GetAutoScalingGroupSchedules(
-		ctx,
-		&ascTypes.GetAutoScalingGroupSchedulesInput{},
-	)
+func ListSchedulesForAllGroups(cmd *cobra.Command) error {
+	results, err := cmdutil.FanOut(cmd, asg.NewAutoScalingService, func(ctx context.Context, svc *asg.AutoScalingService) ([]any, error) {
+		schedules, err := svc.GetAutoScalingGroupSchedules(
+			ctx,
+			&ascTypes.GetAutoScalingGroupSchedulesInput{},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("get schedules for all Auto Scaling Groups: %w", err)
+		}
+		return utils.SlicesToAny(schedules), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get schedules for all Auto Scaling Groups: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Scheduled Actions",
 		PlainStyle:    list,
-		Fields:        getScheduleFields(),
-		Data:          utils.SlicesToAny(schedules),
-		GetFieldValue: asg.GetFieldValue,
-		GetTagValue:   asg.GetTagValue,
+		Fields:        results.Fields(getScheduleFields()),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(asg.GetFieldValue),
+		GetTagValue:   results.TagGetter(asg.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
@@ -0,0 +1,71 @@
+// plan.go renders the dry-run plan for the add and rm schedule subcommands, and records their
+// changes in the journal.
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/harleymckenzie/asc/internal/service/asg"
+	ascTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// planFields are the fields of a scheduled action shown in a dry-run plan.
+var planFields = []string{"Recurrence", "Start Time", "End Time", "Desired Capacity", "Min", "Max"}
+
+// getSchedule returns the scheduled action with the given name, or nil if it does not exist.
+func getSchedule(ctx context.Context, svc *asg.AutoScalingService, asgName string, name string) (*types.ScheduledUpdateGroupAction, error) {
+	schedules, err := svc.GetAutoScalingGroupSchedules(ctx, &ascTypes.GetAutoScalingGroupSchedulesInput{
+		AutoScalingGroupName: asgName,
+		ScheduledActionNames: []string{name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get schedule: %w", err)
+	}
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+	return &schedules[0], nil
+}
+
+// renderSchedulePlan renders the changes between the current scheduled action and the one that
+// would replace it. A nil before means the action would be created, and a nil after that it
+// would be removed.
+func renderSchedulePlan(name string, before, after *types.ScheduledUpdateGroupAction) {
+	var changes []cmdutil.Change
+	for _, field := range planFields {
+		beforeValue, afterValue := scheduleValue(field, before), scheduleValue(field, after)
+		if beforeValue == "" && afterValue == "" {
+			continue
+		}
+		changes = append(changes, cmdutil.Change{
+			Resource: name,
+			Field:    field,
+			Before:   beforeValue,
+			After:    afterValue,
+		})
+	}
+	cmdutil.RenderPlan(changes)
+}
+
+// scheduleValue returns the value of a field of a scheduled action, or "" if the action is nil.
+func scheduleValue(field string, schedule *types.ScheduledUpdateGroupAction) string {
+	if schedule == nil {
+		return ""
+	}
+	value, _ := asg.GetScheduleAttributeValue(field, *schedule)
+	return value
+}
+
+// recordScheduleChange records a change to a scheduled action in the journal, with the action as
+// it was before the change. A nil before means the action did not exist.
+func recordScheduleChange(cmd *cobra.Command, asgName string, name string, before *types.ScheduledUpdateGroupAction) {
+	state := map[string]string{}
+	if before != nil {
+		state = asg.ScheduleState(*before)
+	}
+	cmdutil.RecordChange(cmd, "asg://schedule/"+asgName+"/"+name, "asg/schedule", state)
+}
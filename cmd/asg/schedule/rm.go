@@ -4,6 +4,7 @@ package schedule
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/asg"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -23,8 +24,10 @@ var rmCmd = &cobra.Command{
 
 // NewRmFlags adds flags for the rm subcommand.
 func NewRmFlags(cobraCmd *cobra.Command) {
+	cmdutil.AllowDryRun(cobraCmd)
 	cobraCmd.Flags().StringVarP(&asgName, "asg-name", "a", "", "The name of the Auto Scaling Group")
 	cobraCmd.MarkFlagRequired("asg-name")
+	completion.RegisterFlag(cobraCmd, "asg-name", completion.GroupNameFlag())
 }
 
 func init() {
@@ -45,6 +48,19 @@ func RemoveSchedule(cobraCmd *cobra.Command, args []string) error {
 		return fmt.Errorf("create new Auto Scaling Group service: %w", err)
 	}
 
+	existing, err := getSchedule(ctx, svc, asgName, args[0])
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("schedule %s not found for Auto Scaling Group %s", args[0], asgName)
+	}
+
+	if cmdutil.IsDryRun(cobraCmd) {
+		renderSchedulePlan(args[0], existing, nil)
+		return nil
+	}
+
 	err = svc.RemoveAutoScalingGroupSchedule(ctx, &ascTypes.RemoveAutoScalingGroupScheduleInput{
 		AutoScalingGroupName: asgName,
 		ScheduledActionName:  args[0],
@@ -52,5 +68,6 @@ func RemoveSchedule(cobraCmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("remove schedule: %w", err)
 	}
+	recordScheduleChange(cobraCmd, asgName, args[0], existing)
 	return nil
 }
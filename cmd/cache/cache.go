@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCacheCmd creates the cache command.
+func NewCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the local cache of AWS responses",
+		Long: `Manage the local cache of AWS responses.
+
+Responses to read-only calls are cached when --cache-ttl is set, and shell completions are
+cached for a minute. Role sessions assumed with --role-arn are cached until they expire. The
+cache is stored in $XDG_CACHE_HOME/asc, or ~/.cache/asc.`,
+	}
+	cmd.AddCommand(newClearCmd())
+	return cmd
+}
+
+func newClearCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear",
+		Short: "Remove every cached response, completion and role session",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(ClearCache(cmd, args))
+		},
+	}
+}
+
+// ClearCache removes the asc cache directory.
+func ClearCache(cmd *cobra.Command, args []string) error {
+	if err := awsutil.ClearCache(); err != nil {
+		return err
+	}
+	fmt.Println("Cache cleared")
+	return nil
+}
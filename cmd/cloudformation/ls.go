@@ -3,6 +3,7 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/cloudformation"
@@ -19,9 +20,6 @@ var (
 	list            bool
 	showLastUpdated bool
 	showDescription bool
-	sortName        bool
-	sortStatus      bool
-	sortLastUpdate  bool
 	reverseSort     bool
 )
 
@@ -33,10 +31,10 @@ func init() {
 // Column functions
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Stack Name", Category: "CloudFormation", Visible: true, SortBy: sortName, SortDirection: tablewriter.Asc},
-		{Name: "Status", Category: "CloudFormation", Visible: true, SortBy: sortStatus, SortDirection: tablewriter.Asc},
+		{Name: "Stack Name", Category: "CloudFormation", Visible: true},
+		{Name: "Status", Category: "CloudFormation", Visible: true},
 		{Name: "Description", Category: "CloudFormation", Visible: showDescription},
-		{Name: "Last Updated", Category: "CloudFormation", Visible: true, SortBy: sortLastUpdate, SortDirection: tablewriter.Desc, DefaultSort: true},
+		{Name: "Last Updated", Category: "CloudFormation", Visible: true, SortDirection: tablewriter.Desc, DefaultSort: true},
 	}
 }
 
@@ -53,37 +51,44 @@ var lsCmd = &cobra.Command{
 // Flag function
 func newLsFlags(lsCmd *cobra.Command) {
 	lsCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs CloudFormation stacks in list format.")
+	cmdutil.AddColumnsFlag(lsCmd, getListFields)
+	cmdutil.AddSortFlag(lsCmd, getListFields)
+	cmdutil.AddFilterFlag(lsCmd, getListFields)
+	cmdutil.AddTargetFlags(lsCmd)
 	lsCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
-	lsCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by descending CloudFormation stack name.")
-	lsCmd.Flags().BoolVarP(&sortStatus, "sort-status", "s", false, "Sort by descending CloudFormation stack status.")
-	lsCmd.Flags().BoolVarP(&sortLastUpdate, "sort-last-update", "u", false, "Sort by descending CloudFormation stack last updated date.")
+	cmdutil.AddSortAlias(lsCmd, "sort-name", "n", "Stack Name")
+	cmdutil.AddSortAlias(lsCmd, "sort-status", "s", "Status")
+	cmdutil.AddSortAlias(lsCmd, "sort-last-update", "u", "Last Updated:desc")
 	lsCmd.Flags().BoolVarP(&showDescription, "show-description", "d", false, "Show the description of the CloudFormation stack.")
 	lsCmd.Flags().BoolVarP(&showLastUpdated, "show-last-updated", "U", false, "Show the last updated date of the CloudFormation stack.")
 }
 
 // Command functions
 func ListCloudFormationStacks(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, cloudformation.NewCloudFormationService)
-	if err != nil {
-		return fmt.Errorf("create new CloudFormation service: %w", err)
-	}
-
-	stacks, err := svc.GetStacks(cmd.Context(), &ascTypes.GetStacksInput{
-		StackName: nil,
+	results, err := cmdutil.FanOut(cmd, cloudformation.NewCloudFormationService, func(ctx context.Context, svc *cloudformation.CloudFormationService) ([]any, error) {
+		stacks, err := svc.GetStacks(ctx, &ascTypes.GetStacksInput{
+			StackName: nil,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list CloudFormation stacks: %w", err)
+		}
+		return utils.SlicesToAny(stacks), nil
 	})
 	if err != nil {
-		return fmt.Errorf("list CloudFormation stacks: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Stacks",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(stacks),
-		GetFieldValue: cloudformation.GetFieldValue,
-		GetTagValue:   cloudformation.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(cloudformation.GetFieldValue),
+		GetTagValue:   results.TagGetter(cloudformation.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
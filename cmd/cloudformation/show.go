@@ -5,6 +5,7 @@ package cloudformation
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/cloudformation"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/cloudformation/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -48,6 +49,7 @@ var showCmd = &cobra.Command{
 	RunE: func(cobraCmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ShowCloudFormationStack(cobraCmd, args))
 	},
+	ValidArgsFunction: completion.StackNames(1),
 }
 
 // Flag function
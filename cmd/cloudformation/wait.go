@@ -1,12 +1,17 @@
 package cloudformation
 
 import (
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/cmd/wait"
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/spf13/cobra"
 )
 
+func init() {
+	wait.AddFlags(waitCmd)
+}
+
 var waitCmd = &cobra.Command{
 	Use:     "wait <stack-name>",
 	Short:   "Wait for a CloudFormation stack to reach a stable state",
@@ -15,6 +20,7 @@ var waitCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(WaitStack(cmd, args))
 	},
+	ValidArgsFunction: completion.StackNames(1),
 }
 
 func WaitStack(cmd *cobra.Command, args []string) error {
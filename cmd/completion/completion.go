@@ -0,0 +1,347 @@
+// Package completion provides shell completion of live resource identifiers, such as instance
+// IDs and SSM parameter names, for the commands that take them.
+package completion
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/spf13/cobra"
+
+	"github.com/harleymckenzie/asc/internal/service/asg"
+	asgTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
+	"github.com/harleymckenzie/asc/internal/service/cloudformation"
+	cfTypes "github.com/harleymckenzie/asc/internal/service/cloudformation/types"
+	"github.com/harleymckenzie/asc/internal/service/ec2"
+	ec2Types "github.com/harleymckenzie/asc/internal/service/ec2/types"
+	"github.com/harleymckenzie/asc/internal/service/ecs"
+	ecsTypes "github.com/harleymckenzie/asc/internal/service/ecs/types"
+	"github.com/harleymckenzie/asc/internal/service/efs"
+	"github.com/harleymckenzie/asc/internal/service/rds"
+	rdsTypes "github.com/harleymckenzie/asc/internal/service/rds/types"
+	"github.com/harleymckenzie/asc/internal/service/ssm"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+)
+
+// InstanceIDs completes EC2 instance IDs, described by their Name tag and state.
+func InstanceIDs(maxArgs int) cobra.CompletionFunc {
+	return cmdutil.CompleteResources("ec2/instance", maxArgs, listInstanceIDs)
+}
+
+func listInstanceIDs(ctx context.Context, profile, region, _ string) ([]string, error) {
+	svc, err := ec2.NewEC2Service(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := svc.GetInstances(ctx, &ec2Types.GetInstancesInput{})
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		name, _ := ec2.GetTagValue("Name", instance)
+		if name == "" {
+			name = "-"
+		}
+		completions = append(completions, fmt.Sprintf("%s\t%s (%s)", aws.ToString(instance.InstanceId), name, instance.State.Name))
+	}
+	return completions, nil
+}
+
+// InstanceTagKeys completes the tag keys of EC2 instances for the --tags flag.
+func InstanceTagKeys() cobra.CompletionFunc {
+	return cmdutil.CompleteFlagList("ec2/instance/tag-keys", func(ctx context.Context, profile, region, _ string) ([]string, error) {
+		svc, err := ec2.NewEC2Service(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		instances, err := svc.GetInstances(ctx, &ec2Types.GetInstancesInput{})
+		if err != nil {
+			return nil, err
+		}
+		keys := map[string]bool{}
+		for _, instance := range instances {
+			for _, tag := range instance.Tags {
+				keys[aws.ToString(tag.Key)] = true
+			}
+		}
+		return sortedKeys(keys), nil
+	})
+}
+
+// GroupNames completes Auto Scaling Group names.
+func GroupNames(maxArgs int) cobra.CompletionFunc {
+	return cmdutil.CompleteResources("asg/auto-scaling-group", maxArgs, listGroupNames)
+}
+
+// GroupNameFlag completes Auto Scaling Group names for a flag.
+func GroupNameFlag() cobra.CompletionFunc {
+	return cmdutil.CompleteFlagResources("asg/auto-scaling-group", listGroupNames)
+}
+
+func listGroupNames(ctx context.Context, profile, region, _ string) ([]string, error) {
+	svc, err := asg.NewAutoScalingService(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := svc.GetAutoScalingGroups(ctx, &asgTypes.GetAutoScalingGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]string, 0, len(groups))
+	for _, group := range groups {
+		completions = append(completions, fmt.Sprintf("%s\t%d/%d/%d (min/desired/max)",
+			aws.ToString(group.AutoScalingGroupName),
+			aws.ToInt32(group.MinSize), aws.ToInt32(group.DesiredCapacity), aws.ToInt32(group.MaxSize)))
+	}
+	return completions, nil
+}
+
+// DBInstances completes RDS instance identifiers, described by their engine and status.
+func DBInstances(maxArgs int) cobra.CompletionFunc {
+	return cmdutil.CompleteResources("rds/instance", maxArgs, listDBInstances)
+}
+
+func listDBInstances(ctx context.Context, profile, region, _ string) ([]string, error) {
+	svc, err := rds.NewRDSService(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := svc.GetInstances(ctx, &rdsTypes.GetInstancesInput{})
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		completions = append(completions, fmt.Sprintf("%s\t%s (%s)",
+			aws.ToString(instance.DBInstanceIdentifier), aws.ToString(instance.Engine), aws.ToString(instance.DBInstanceStatus)))
+	}
+	return completions, nil
+}
+
+// DBTagKeys completes the tag keys of RDS instances and clusters for the --tags flag.
+func DBTagKeys() cobra.CompletionFunc {
+	return cmdutil.CompleteFlagList("rds/tag-keys", func(ctx context.Context, profile, region, _ string) ([]string, error) {
+		svc, err := rds.NewRDSService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		instances, err := svc.GetInstances(ctx, &rdsTypes.GetInstancesInput{})
+		if err != nil {
+			return nil, err
+		}
+		clusters, err := svc.GetClusters(ctx, &rdsTypes.GetClustersInput{})
+		if err != nil {
+			return nil, err
+		}
+		keys := map[string]bool{}
+		for _, instance := range instances {
+			for _, tag := range instance.TagList {
+				keys[aws.ToString(tag.Key)] = true
+			}
+		}
+		for _, cluster := range clusters {
+			for _, tag := range cluster.TagList {
+				keys[aws.ToString(tag.Key)] = true
+			}
+		}
+		return sortedKeys(keys), nil
+	})
+}
+
+// FileSystemTagKeys completes the tag keys of EFS file systems for the --tags flag.
+func FileSystemTagKeys() cobra.CompletionFunc {
+	return cmdutil.CompleteFlagList("efs/file-system/tag-keys", func(ctx context.Context, profile, region, _ string) ([]string, error) {
+		svc, err := efs.NewEFSService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		fileSystems, err := svc.GetFileSystems(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys := map[string]bool{}
+		for _, fileSystem := range fileSystems {
+			for _, tag := range fileSystem.Tags {
+				keys[aws.ToString(tag.Key)] = true
+			}
+		}
+		return sortedKeys(keys), nil
+	})
+}
+
+// StackNames completes CloudFormation stack names, described by their status.
+func StackNames(maxArgs int) cobra.CompletionFunc {
+	return cmdutil.CompleteResources("cf/stack", maxArgs, listStackNames)
+}
+
+func listStackNames(ctx context.Context, profile, region, _ string) ([]string, error) {
+	svc, err := cloudformation.NewCloudFormationService(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+	stacks, err := svc.GetStacks(ctx, &cfTypes.GetStacksInput{})
+	if err != nil {
+		return nil, err
+	}
+	completions := make([]string, 0, len(stacks))
+	for _, stack := range stacks {
+		completions = append(completions, fmt.Sprintf("%s\t%s", aws.ToString(stack.StackName), stack.StackStatus))
+	}
+	return completions, nil
+}
+
+// ECSClusters completes ECS cluster names.
+func ECSClusters(maxArgs int) cobra.CompletionFunc {
+	return cmdutil.CompleteResources("ecs/cluster", maxArgs, listECSClusters)
+}
+
+// ECSClusterFlag completes ECS cluster names for a flag, such as --cluster.
+func ECSClusterFlag() cobra.CompletionFunc {
+	return cmdutil.CompleteFlagResources("ecs/cluster", listECSClusters)
+}
+
+func listECSClusters(ctx context.Context, profile, region, _ string) ([]string, error) {
+	svc, err := ecs.NewECSService(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+	arns, err := svc.ListClusters(ctx, &ecsTypes.ListClustersInput{})
+	if err != nil {
+		return nil, err
+	}
+	return arnNames(arns), nil
+}
+
+// ECSServices completes the names of the ECS services in the cluster given by the command's
+// --cluster flag, or the default cluster.
+func ECSServices(maxArgs int) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		cluster, _ := cmd.Flags().GetString("cluster")
+		complete := cmdutil.CompleteResources("ecs/service/"+cluster, maxArgs,
+			func(ctx context.Context, profile, region, _ string) ([]string, error) {
+				svc, err := ecs.NewECSService(ctx, profile, region)
+				if err != nil {
+					return nil, err
+				}
+				arns, err := svc.ListServices(ctx, &ecsTypes.ListServicesInput{Cluster: cluster})
+				if err != nil {
+					return nil, err
+				}
+				return arnNames(arns), nil
+			})
+		return complete(cmd, args, toComplete)
+	}
+}
+
+// ECSClusterTagKeys completes the tag keys of ECS clusters for the --tags flag.
+func ECSClusterTagKeys() cobra.CompletionFunc {
+	return cmdutil.CompleteFlagList("ecs/cluster/tag-keys", func(ctx context.Context, profile, region, _ string) ([]string, error) {
+		svc, err := ecs.NewECSService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		clusters, err := svc.GetAllClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys := map[string]bool{}
+		for _, cluster := range clusters {
+			for _, tag := range cluster.Tags {
+				keys[aws.ToString(tag.Key)] = true
+			}
+		}
+		return sortedKeys(keys), nil
+	})
+}
+
+// ECSServiceTagKeys completes the tag keys of the ECS services in the cluster given by the
+// command's --cluster flag, or every cluster, for the --tags flag.
+func ECSServiceTagKeys() cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		cluster, _ := cmd.Flags().GetString("cluster")
+		complete := cmdutil.CompleteFlagList("ecs/service/"+cluster+"/tag-keys",
+			func(ctx context.Context, profile, region, _ string) ([]string, error) {
+				svc, err := ecs.NewECSService(ctx, profile, region)
+				if err != nil {
+					return nil, err
+				}
+				services, err := svc.GetAllServices(ctx, cluster)
+				if err != nil {
+					return nil, err
+				}
+				keys := map[string]bool{}
+				for _, service := range services {
+					for _, tag := range service.Tags {
+						keys[aws.ToString(tag.Key)] = true
+					}
+				}
+				return sortedKeys(keys), nil
+			})
+		return complete(cmd, args, toComplete)
+	}
+}
+
+// ECSTaskTagKeys completes the tag keys of the ECS tasks in the cluster given by the command's
+// --cluster flag, or every cluster, for the --tags flag.
+func ECSTaskTagKeys() cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		cluster, _ := cmd.Flags().GetString("cluster")
+		complete := cmdutil.CompleteFlagList("ecs/task/"+cluster+"/tag-keys",
+			func(ctx context.Context, profile, region, _ string) ([]string, error) {
+				svc, err := ecs.NewECSService(ctx, profile, region)
+				if err != nil {
+					return nil, err
+				}
+				tasks, err := svc.GetAllTasks(ctx, cluster, "")
+				if err != nil {
+					return nil, err
+				}
+				keys := map[string]bool{}
+				for _, task := range tasks {
+					for _, tag := range task.Tags {
+						keys[aws.ToString(tag.Key)] = true
+					}
+				}
+				return sortedKeys(keys), nil
+			})
+		return complete(cmd, args, toComplete)
+	}
+}
+
+// sortedKeys returns the keys of a set in order.
+func sortedKeys(set map[string]bool) []string {
+	return slices.Sorted(maps.Keys(set))
+}
+
+// arnNames returns the resource names at the end of ARNs, such as the cluster name of
+// arn:aws:ecs:eu-west-1:123456789012:cluster/my-cluster.
+func arnNames(arns []string) []string {
+	names := make([]string, 0, len(arns))
+	for _, arn := range arns {
+		names = append(names, path.Base(arn))
+	}
+	return names
+}
+
+// ParameterNames completes SSM parameter names one path segment at a time.
+func ParameterNames(maxArgs int) cobra.CompletionFunc {
+	return cmdutil.CompletePaths("ssm/parameter", maxArgs, func(ctx context.Context, profile, region, parent string) ([]string, error) {
+		svc, err := ssm.NewSSMService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		return svc.CompleteParameterPath(ctx, parent)
+	})
+}
+
+// RegisterFlag registers fn to complete the values of a flag of cmd.
+func RegisterFlag(cmd *cobra.Command, name string, fn cobra.CompletionFunc) {
+	if err := cmd.RegisterFlagCompletionFunc(name, fn); err != nil {
+		panic(err)
+	}
+}
@@ -2,6 +2,7 @@
 package ami
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -15,13 +16,9 @@ import (
 )
 
 var (
-	list             bool
-	sortID           bool
-	sortName         bool
-	sortState        bool
-	sortCreationDate bool
-	showDesc         bool
-	reverseSort      bool
+	list        bool
+	showDesc    bool
+	reverseSort bool
 
 	scope      string // Combined owner/visibility flag
 	nameFilter string
@@ -35,13 +32,13 @@ func init() {
 
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "AMI Name", Category: "AMI Details", Visible: true, SortBy: sortName, SortDirection: tablewriter.Asc},
-		{Name: "AMI ID", Category: "AMI Details", Visible: true, SortBy: sortID, SortDirection: tablewriter.Asc},
+		{Name: "AMI Name", Category: "AMI Details", Visible: true},
+		{Name: "AMI ID", Category: "AMI Details", Visible: true},
 		{Name: "Source", Category: "AMI Details", Visible: false},
 		{Name: "Owner", Category: "AMI Details", Visible: true},
 		{Name: "Visibility", Category: "AMI Details", Visible: false},
-		{Name: "Status", Category: "AMI Details", Visible: true, SortBy: sortState, SortDirection: tablewriter.Desc},
-		{Name: "Creation Date", Category: "AMI Details", DefaultSort: true, Visible: true, SortBy: sortCreationDate, SortDirection: tablewriter.Desc},
+		{Name: "Status", Category: "AMI Details", Visible: true},
+		{Name: "Creation Date", Category: "AMI Details", DefaultSort: true, Visible: true, SortDirection: tablewriter.Desc},
 		{Name: "Platform", Category: "AMI Details", Visible: false},
 		{Name: "Root Device Type", Category: "AMI Details", Visible: false},
 		{Name: "Block Devices", Category: "AMI Details", Visible: false},
@@ -62,10 +59,14 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs AMIs in list format.")
-	cobraCmd.Flags().BoolVarP(&sortID, "sort-id", "i", false, "Sort by descending image ID.")
-	cobraCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by descending image name.")
-	cobraCmd.Flags().BoolVarP(&sortState, "sort-state", "s", false, "Sort by descending image state.")
-	cobraCmd.Flags().BoolVarP(&sortCreationDate, "sort-creation-date", "c", false, "Sort by descending image creation date.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "AMI ID")
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "AMI Name")
+	cmdutil.AddSortAlias(cobraCmd, "sort-state", "s", "Status:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-creation-date", "c", "Creation Date:desc")
 	cobraCmd.Flags().BoolVarP(&showDesc, "show-description", "d", false, "Show the AMI description column.")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
 	cobraCmd.Flags().StringVar(&scope, "scope", "self", "Scope of AMIs to list: self (your private AMIs), private (all private AMIs you can access), public, amazon, all, or AWS account ID.")
@@ -74,34 +75,37 @@ func NewLsFlags(cobraCmd *cobra.Command) {
 }
 
 func ListAMIs(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, ec2.NewEC2Service)
-	if err != nil {
-		return fmt.Errorf("create ec2 service: %w", err)
-	}
-
 	filters, owners, err := parseScope(scope)
 	if err != nil {
 		return fmt.Errorf("parse scope: %w", err)
 	}
-	amis, err := getImages(cmd.Context(), svc, &ascTypes.GetImagesInput{
-		Filters: filters,
-		Owners:  owners,
+	results, err := cmdutil.FanOut(cmd, ec2.NewEC2Service, func(ctx context.Context, svc *ec2.EC2Service) ([]any, error) {
+		amis, err := getImages(ctx, svc, &ascTypes.GetImagesInput{
+			Filters: filters,
+			Owners:  owners,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get images: %w", err)
+		}
+		return utils.SlicesToAny(amis), nil
 	})
 	if err != nil {
-		return fmt.Errorf("get images: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "AMIs",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(amis),
-		GetFieldValue: ec2.GetFieldValue,
-		GetTagValue:   ec2.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ec2.GetFieldValue),
+		GetTagValue:   results.TagGetter(ec2.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
 
 func parseScope(scope string) ([]types.Filter, []string, error) {
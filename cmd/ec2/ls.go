@@ -3,9 +3,12 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ec2"
+	ascTypes "github.com/harleymckenzie/asc/internal/service/ec2/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 	"github.com/harleymckenzie/asc/internal/shared/utils"
@@ -20,11 +23,6 @@ var (
 	showPrivateIP  bool
 	showSubnet     bool
 
-	sortByID         bool
-	sortByType       bool
-	sortByLaunchTime bool
-	sortByPrivateIP  bool
-
 	reverseSort bool
 )
 
@@ -38,19 +36,19 @@ func init() {
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
 		{Name: "Name", Category: "Instance Details", Visible: true, DefaultSort: true},
-		{Name: "Instance ID", Category: "Instance Details", Visible: true, SortBy: sortByID, SortDirection: tablewriter.Asc},
+		{Name: "Instance ID", Category: "Instance Details", Visible: true},
 		{Name: "State", Category: "Instance Details", Visible: true},
 		{Name: "AMI ID", Category: "Instance Details", Visible: showAMI},
 		{Name: "AMI Name", Category: "Instance Details", Visible: false},
-		{Name: "Launch Time", Category: "Instance Details", Visible: showLaunchTime, SortBy: sortByLaunchTime, SortDirection: tablewriter.Desc},
-		{Name: "Instance Type", Category: "Instance Details", Visible: true, SortBy: sortByType, SortDirection: tablewriter.Asc},
+		{Name: "Launch Time", Category: "Instance Details", Visible: showLaunchTime},
+		{Name: "Instance Type", Category: "Instance Details", Visible: true},
 		{Name: "Placement Group", Category: "Instance Details", Visible: false},
 		{Name: "Root Device Type", Category: "Instance Details", Visible: false},
 		{Name: "Root Device Name", Category: "Instance Details", Visible: false},
 		{Name: "Virtualization Type", Category: "Instance Details", Visible: false},
 		{Name: "vCPUs", Category: "Instance Details", Visible: false},
 		{Name: "Public IP", Category: "Network", Visible: true},
-		{Name: "Private IP", Category: "Network", Visible: showPrivateIP, SortBy: sortByPrivateIP, SortDirection: tablewriter.Asc},
+		{Name: "Private IP", Category: "Network", Visible: showPrivateIP},
 		{Name: "Subnet ID", Category: "Network", Visible: showSubnet},
 		{Name: "VPC ID", Category: "Network", Visible: false},
 		{Name: "Availability Zone", Category: "Network", Visible: false},
@@ -83,38 +81,53 @@ func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&showLaunchTime, "launch-time", "L", false, "Show the launch time of the instance.")
 	cobraCmd.Flags().BoolVarP(&showPrivateIP, "private-ip", "I", false, "Show the private IP address of the instance.")
 	cobraCmd.Flags().BoolVarP(&showSubnet, "subnet", "S", false, "Show the subnet ID of the instance.")
-	cmdutil.AddTagFlag(cobraCmd)
+	cmdutil.AddTagFlag(cobraCmd, completion.InstanceTagKeys())
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 
 	// Sorting flags
-	cobraCmd.Flags().BoolVarP(&sortByID, "sort-id", "i", false, "Sort by descending EC2 instance Id.")
-	cobraCmd.Flags().BoolVarP(&sortByType, "sort-type", "T", false, "Sort by descending EC2 instance type.")
-	cobraCmd.Flags().BoolVarP(&sortByLaunchTime, "sort-launch-time", "t", false, "Sort by descending launch time (most recently launched first).")
-	cobraCmd.Flags().BoolVarP(&sortByPrivateIP, "sort-private-ip", "P", false, "Sort by ascending private IP address.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Instance ID")
+	cmdutil.AddSortAlias(cobraCmd, "sort-type", "T", "Instance Type")
+	cmdutil.AddSortAlias(cobraCmd, "sort-launch-time", "t", "Launch Time:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-private-ip", "P", "Private IP")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
 	cobraCmd.MarkFlagsMutuallyExclusive("sort-id", "sort-type", "sort-launch-time", "sort-private-ip")
 }
 
 // ListEC2Instances handles the listing of EC2 instances and related resources
 func ListEC2Instances(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, ec2.NewEC2Service)
+	filters, err := tablewriter.ParseFilters(cmdutil.Filters)
 	if err != nil {
-		return fmt.Errorf("create ec2 service: %w", err)
+		return err
 	}
 
-	instances, err := getInstances(cmd.Context(), svc, args)
+	results, err := cmdutil.FanOut(cmd, ec2.NewEC2Service, func(ctx context.Context, svc *ec2.EC2Service) ([]any, error) {
+		instances, err := svc.GetInstances(ctx, &ascTypes.GetInstancesInput{
+			InstanceIDs: args,
+			Filters:     ec2.InstanceFilters(filters),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get instances: %w", err)
+		}
+		return utils.SlicesToAny(instances), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get instances: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Instances",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(instances),
-		GetFieldValue: ec2.GetFieldValue,
-		GetTagValue:   ec2.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ec2.GetFieldValue),
+		GetTagValue:   results.TagGetter(ec2.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
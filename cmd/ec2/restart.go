@@ -3,6 +3,7 @@ package ec2
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ec2"
 	"github.com/spf13/cobra"
 
@@ -14,11 +15,13 @@ var restartCmd = &cobra.Command{
 	Use:     "restart",
 	Short:   "Restart an EC2 instance",
 	Aliases: []string{"reboot"},
-	Example: "asc ec2 restart i-1234567890abcdef0",
+	Example: "asc ec2 restart i-1234567890abcdef0\n" +
+		"asc ec2 restart @",
 	GroupID: "actions",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(RestartEC2Instance(cmd, args))
 	},
+	ValidArgsFunction: completion.InstanceIDs(0),
 }
 
 func RestartEC2Instance(cmd *cobra.Command, args []string) error {
@@ -29,25 +32,52 @@ func RestartEC2Instance(cmd *cobra.Command, args []string) error {
 		cmd.Help()
 		return nil
 	}
+	ids, ok, err := cmdutil.ConfirmSelectedIDs(cmd, args, "ec2", "instance", "Restart")
+	if err != nil || !ok {
+		return err
+	}
 
 	svc, err := ec2.NewEC2Service(ctx, profile, region)
 	if err != nil {
 		return fmt.Errorf("create new EC2 service: %w", err)
 	}
 
-	err = svc.RestartInstance(ctx, &ascTypes.RestartInstanceInput{
-		InstanceID: args[0],
-	})
-	if err != nil {
-		return fmt.Errorf("restart instance: %w", err)
+	dryRun := cmdutil.IsDryRun(cmd)
+	var plan []cmdutil.Change
+	for _, id := range ids {
+		before, err := svc.GetInstanceStatus(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get instance state %s: %w", id, err)
+		}
+
+		err = svc.RestartInstance(ctx, &ascTypes.RestartInstanceInput{
+			InstanceID: id,
+			DryRun:     dryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("restart instance %s: %w", id, err)
+		}
+
+		if dryRun {
+			plan = append(plan, stateChange(id, before, "rebooting"))
+			continue
+		}
+		recordStateChange(cmd, id, "", before)
+
+		fmt.Printf("Reboot request sent to instance %s\n", id)
 	}
 
-	fmt.Printf("Reboot request sent to instance %s\n", args[0])
+	if dryRun {
+		cmdutil.RenderPlan(plan)
+	}
 	return nil
 }
 
-func newRestartFlags(cmd *cobra.Command) {}
+func newRestartFlags(cmd *cobra.Command) {
+	cmdutil.AddYesFlag(cmd)
+}
 
 func init() {
+	cmdutil.AllowDryRun(restartCmd)
 	newRestartFlags(restartCmd)
 }
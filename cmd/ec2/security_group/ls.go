@@ -2,6 +2,7 @@
 package security_group
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/ec2"
@@ -14,10 +15,6 @@ import (
 
 var (
 	list        bool
-	sortID      bool
-	sortName    bool
-	sortVPCID   bool
-	sortOwnerID bool
 	showDesc    bool
 	showOwnerID bool
 	reverseSort bool
@@ -40,21 +37,25 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs security groups in list format.")
-	cobraCmd.Flags().BoolVarP(&sortID, "sort-id", "i", false, "Sort by descending group ID.")
-	cobraCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by descending group name.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Group ID")
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "Group Name")
 	cobraCmd.Flags().BoolVarP(&showDesc, "show-description", "d", false, "Show the security group description column.")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
-	cobraCmd.Flags().BoolVarP(&sortVPCID, "sort-vpc-id", "v", false, "Sort by descending VPC ID.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-vpc-id", "v", "VPC ID")
 	cobraCmd.Flags().BoolVarP(&showOwnerID, "show-owner-id", "O", false, "Show the security group owner ID column.")
 }
 
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Group Name", Category: "Security Group", Visible: true, DefaultSort: true, SortBy: sortName, SortDirection: tablewriter.Asc},
-		{Name: "Group ID", Category: "Security Group", Visible: true, SortBy: sortID, SortDirection: tablewriter.Asc},
+		{Name: "Group Name", Category: "Security Group", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
+		{Name: "Group ID", Category: "Security Group", Visible: true},
 		{Name: "Description", Category: "Security Group", Visible: showDesc},
-		{Name: "VPC ID", Category: "Security Group", Visible: true, SortBy: sortVPCID, SortDirection: tablewriter.Asc},
-		{Name: "Owner ID", Category: "Security Group", Visible: showOwnerID, SortBy: sortOwnerID, SortDirection: tablewriter.Asc},
+		{Name: "VPC ID", Category: "Security Group", Visible: true},
+		{Name: "Owner ID", Category: "Security Group", Visible: showOwnerID},
 		{Name: "Ingress Count", Category: "Security Group", Visible: true, SortDirection: tablewriter.Desc},
 		{Name: "Egress Count", Category: "Security Group", Visible: true, SortDirection: tablewriter.Desc},
 		{Name: "Tag Count", Category: "Security Group", Visible: false},
@@ -69,27 +70,30 @@ func ListSecurityGroups(cmd *cobra.Command, args []string) error {
 		return ListSecurityGroupRules(cmd, args)
 	}
 
-	svc, err := cmdutil.CreateService(cmd, ec2.NewEC2Service)
-	if err != nil {
-		return fmt.Errorf("create ec2 service: %w", err)
-	}
-
-	groups, err := svc.GetSecurityGroups(cmd.Context(), &ascTypes.GetSecurityGroupsInput{})
+	results, err := cmdutil.FanOut(cmd, ec2.NewEC2Service, func(ctx context.Context, svc *ec2.EC2Service) ([]any, error) {
+		groups, err := svc.GetSecurityGroups(ctx, &ascTypes.GetSecurityGroupsInput{})
+		if err != nil {
+			return nil, fmt.Errorf("get security groups: %w", err)
+		}
+		return utils.SlicesToAny(groups), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get security groups: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Security Groups",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(groups),
-		GetFieldValue: ec2.GetFieldValue,
-		GetTagValue:   ec2.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ec2.GetFieldValue),
+		GetTagValue:   results.TagGetter(ec2.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
 
 func getListRulesFields() []tablewriter.Field {
@@ -118,25 +122,43 @@ func ListSecurityGroupRules(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("get security group rules: %w", err)
 	}
 
-	ingressRules := ec2.FilterSecurityGroupRules(rules, false)
-	egressRules := ec2.FilterSecurityGroupRules(rules, true)
+	fields := getListRulesFields()
+	filters, err := tablewriter.ParseFilters(cmdutil.Filters)
+	if err != nil {
+		return err
+	}
+	ingressRules, err := tablewriter.FilterData(filters, fields, utils.SlicesToAny(ec2.FilterSecurityGroupRules(rules, false)), ec2.GetFieldValue, ec2.GetTagValue)
+	if err != nil {
+		return err
+	}
+	egressRules, err := tablewriter.FilterData(filters, fields, utils.SlicesToAny(ec2.FilterSecurityGroupRules(rules, true)), ec2.GetFieldValue, ec2.GetTagValue)
+	if err != nil {
+		return err
+	}
+
+	fields, tags := tablewriter.ShowSortColumns(fields, cmdutil.Tags, cmdutil.Sort)
+	fields = tablewriter.AppendTagFields(fields, tags, ingressRules)
+
+	headerRow := tablewriter.BuildHeaderRow(fields)
+	sortBy, err := tablewriter.ParseSort(cmdutil.Sort, headerRow)
+	if err != nil {
+		return err
+	}
 
 	table := tablewriter.NewAscWriter(tablewriter.AscTableRenderOptions{
 		Title:          fmt.Sprintf("%s - Inbound Rules", args[0]),
 		MaxColumnWidth: 50,
 		Columns:        8,
+		SortBy:         sortBy,
+		MaxRows:        tablewriter.MaxItems,
 	})
 	if list {
 		table.SetRenderStyle("plain")
 	}
-	fields := getListRulesFields()
-	fields = tablewriter.AppendTagFields(fields, cmdutil.Tags, utils.SlicesToAny(ingressRules))
-
-	headerRow := tablewriter.BuildHeaderRow(fields)
 	table.AppendHeader(headerRow)
-	table.AppendRows(tablewriter.BuildRows(utils.SlicesToAny(ingressRules), fields, ec2.GetFieldValue, ec2.GetTagValue))
+	table.AppendRows(tablewriter.BuildRows(ingressRules, fields, ec2.GetFieldValue, ec2.GetTagValue))
 	table.AppendTitleRow(fmt.Sprintf("%s - Outbound Rules", args[0]))
-	table.AppendRows(tablewriter.BuildRows(utils.SlicesToAny(egressRules), fields, ec2.GetFieldValue, ec2.GetTagValue))
+	table.AppendRows(tablewriter.BuildRows(egressRules, fields, ec2.GetFieldValue, ec2.GetTagValue))
 	table.SetFieldConfigs(fields, reverseSort)
 	table.Render()
 	return nil
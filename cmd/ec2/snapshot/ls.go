@@ -2,6 +2,7 @@
 package snapshot
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/ec2"
@@ -14,8 +15,6 @@ import (
 
 var (
 	list        bool
-	sortID      bool
-	sortSize    bool
 	showDesc    bool
 	reverseSort bool
 	owner       string
@@ -28,7 +27,7 @@ func init() {
 
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Snapshot ID", Category: "Snapshot Details", Visible: true, SortBy: sortID, SortDirection: tablewriter.Asc},
+		{Name: "Snapshot ID", Category: "Snapshot Details", Visible: true},
 		{Name: "Volume Size", Category: "Snapshot Details", Visible: true},
 		{Name: "Description", Category: "Snapshot Details", Visible: showDesc},
 		{Name: "Tier", Category: "Snapshot Details", Visible: true},
@@ -55,8 +54,12 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs snapshots in list format.")
-	cobraCmd.Flags().BoolVarP(&sortID, "sort-id", "i", false, "Sort by descending snapshot ID.")
-	cobraCmd.Flags().BoolVarP(&sortSize, "sort-size", "s", false, "Sort by descending snapshot size.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Snapshot ID")
+	cmdutil.AddSortAlias(cobraCmd, "sort-size", "s", "Volume Size:desc")
 	cobraCmd.Flags().BoolVarP(&showDesc, "show-description", "d", false, "Show the snapshot description column.")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
 	cobraCmd.Flags().StringVar(&owner, "owner", "", "Accepts a single AWS account ID or 'all' to show all snapshots. If not provided, only your own snapshots are shown.")
@@ -64,30 +67,33 @@ func NewLsFlags(cobraCmd *cobra.Command) {
 
 // ListSnapshots is the handler for the ls subcommand.
 func ListSnapshots(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, ec2.NewEC2Service)
-	if err != nil {
-		return fmt.Errorf("create ec2 service: %w", err)
-	}
-
 	ownerIds := getOwnerIds(owner)
-	snapshots, err := svc.GetSnapshots(cmd.Context(), &ascTypes.GetSnapshotsInput{
-		OwnerIds: ownerIds,
+	results, err := cmdutil.FanOut(cmd, ec2.NewEC2Service, func(ctx context.Context, svc *ec2.EC2Service) ([]any, error) {
+		snapshots, err := svc.GetSnapshots(ctx, &ascTypes.GetSnapshotsInput{
+			OwnerIds: ownerIds,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get snapshots: %w", err)
+		}
+		return utils.SlicesToAny(snapshots), nil
 	})
 	if err != nil {
-		return fmt.Errorf("get snapshots: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Snapshots",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(snapshots),
-		GetFieldValue: ec2.GetFieldValue,
-		GetTagValue:   ec2.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ec2.GetFieldValue),
+		GetTagValue:   results.TagGetter(ec2.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
 
 // If 'all' is provided, dont use a filter
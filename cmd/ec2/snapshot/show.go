@@ -22,14 +22,14 @@ func init() {
 
 func getShowFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Snapshot ID", Category: "Snapshot Details", Visible: true, SortBy: sortID, SortDirection: tablewriter.Asc},
+		{Name: "Snapshot ID", Category: "Snapshot Details", Visible: true},
 		{Name: "Owner ID", Category: "Snapshot Details", Visible: true},
 		{Name: "Owner Alias", Category: "Snapshot Details", Visible: true},
 		{Name: "Description", Category: "Snapshot Details", Visible: showDesc},
 		{Name: "Tier", Category: "Snapshot Details", Visible: true},
 		{Name: "State", Category: "Snapshot Details", Visible: true},
 		{Name: "Encryption", Category: "Snapshot Details", Visible: true},
-		{Name: "Started", Category: "Snapshot Details", Visible: true, SortBy: sortID, SortDirection: tablewriter.Desc},
+		{Name: "Started", Category: "Snapshot Details", Visible: true},
 		{Name: "Progress", Category: "Snapshot Details", Visible: true},
 		{Name: "Owner ID", Category: "Snapshot Details", Visible: true},
 
@@ -3,6 +3,7 @@ package ec2
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ec2"
 	"github.com/spf13/cobra"
 
@@ -11,18 +12,23 @@ import (
 )
 
 var startCmd = &cobra.Command{
-	Use:     "start",
-	Short:   "Start an EC2 instance",
-	Example: "asc ec2 start i-1234567890abcdef0",
+	Use:   "start",
+	Short: "Start an EC2 instance",
+	Example: "asc ec2 start i-1234567890abcdef0\n" +
+		"asc ec2 start @",
 	GroupID: "actions",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(StartEC2Instance(cmd, args))
 	},
+	ValidArgsFunction: completion.InstanceIDs(0),
 }
 
-func newStartFlags(cmd *cobra.Command) {}
+func newStartFlags(cmd *cobra.Command) {
+	cmdutil.AddYesFlag(cmd)
+}
 
 func init() {
+	cmdutil.AllowDryRun(startCmd)
 	newStartFlags(startCmd)
 }
 
@@ -34,18 +40,42 @@ func StartEC2Instance(cmd *cobra.Command, args []string) error {
 		cmd.Help()
 		return nil
 	}
+	ids, ok, err := cmdutil.ConfirmSelectedIDs(cmd, args, "ec2", "instance", "Start")
+	if err != nil || !ok {
+		return err
+	}
 
 	svc, err := ec2.NewEC2Service(ctx, profile, region)
 	if err != nil {
 		return fmt.Errorf("create new EC2 service: %w", err)
 	}
 
-	err = svc.StartInstance(ctx, &ascTypes.StartInstanceInput{
-		InstanceID: args[0],
-	})
-	if err != nil {
-		return fmt.Errorf("start instance: %w", err)
+	dryRun := cmdutil.IsDryRun(cmd)
+	var plan []cmdutil.Change
+	for _, id := range ids {
+		before, err := svc.GetInstanceStatus(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get instance state %s: %w", id, err)
+		}
+
+		err = svc.StartInstance(ctx, &ascTypes.StartInstanceInput{
+			InstanceID: id,
+			DryRun:     dryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("start instance %s: %w", id, err)
+		}
+
+		if dryRun {
+			plan = append(plan, stateChange(id, before, "running"))
+			continue
+		}
+		recordStateChange(cmd, id, "ec2/start", before)
 	}
 
-	return ListEC2Instances(cmd, []string{args[0]})
+	if dryRun {
+		cmdutil.RenderPlan(plan)
+		return nil
+	}
+	return ListEC2Instances(cmd, ids)
 }
@@ -3,6 +3,7 @@ package ec2
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ec2"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/spf13/cobra"
@@ -19,15 +20,18 @@ var stopCmd = &cobra.Command{
 	Short:   "Stop an EC2 instance",
 	Aliases: []string{"shutdown", "halt"},
 	Example: "asc ec2 stop i-1234567890abcdef0\n" +
-		"asc ec2 stop i-1234567890abcdef0 --force",
+		"asc ec2 stop i-1234567890abcdef0 --force\n" +
+		"asc ec2 stop @",
 	GroupID: "actions",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(StopEC2Instance(cmd, args))
 	},
+	ValidArgsFunction: completion.InstanceIDs(0),
 }
 
 func newStopFlags(stopCmd *cobra.Command) {
 	stopCmd.Flags().BoolVarP(&force, "force", "f", false, "Force stop the EC2 instance")
+	cmdutil.AddYesFlag(stopCmd)
 }
 
 func StopEC2Instance(cmd *cobra.Command, args []string) error {
@@ -38,23 +42,48 @@ func StopEC2Instance(cmd *cobra.Command, args []string) error {
 		cmd.Help()
 		return nil
 	}
+	ids, ok, err := cmdutil.ConfirmSelectedIDs(cmd, args, "ec2", "instance", "Stop")
+	if err != nil || !ok {
+		return err
+	}
 
 	svc, err := ec2.NewEC2Service(ctx, profile, region)
 	if err != nil {
 		return fmt.Errorf("create new EC2 service: %w", err)
 	}
 
-	err = svc.StopInstance(ctx, &ascTypes.StopInstanceInput{
-		InstanceID: args[0],
-		Force:      force,
-	})
-	if err != nil {
-		return fmt.Errorf("stop instance: %w", err)
+	dryRun := cmdutil.IsDryRun(cmd)
+	var plan []cmdutil.Change
+	for _, id := range ids {
+		before, err := svc.GetInstanceStatus(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get instance state %s: %w", id, err)
+		}
+
+		err = svc.StopInstance(ctx, &ascTypes.StopInstanceInput{
+			InstanceID: id,
+			Force:      force,
+			DryRun:     dryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("stop instance %s: %w", id, err)
+		}
+
+		if dryRun {
+			plan = append(plan, stateChange(id, before, "stopped"))
+			continue
+		}
+		recordStateChange(cmd, id, "ec2/stop", before)
 	}
 
-	return ListEC2Instances(cmd, []string{args[0]})
+	if dryRun {
+		cmdutil.RenderPlan(plan)
+		return nil
+	}
+	return ListEC2Instances(cmd, ids)
 }
 
 func init() {
+	cmdutil.AllowDryRun(stopCmd)
 	newStopFlags(stopCmd)
 }
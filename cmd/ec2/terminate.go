@@ -3,6 +3,7 @@ package ec2
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ec2"
 	"github.com/spf13/cobra"
 
@@ -14,16 +15,21 @@ var terminateCmd = &cobra.Command{
 	Use:     "terminate",
 	Short:   "Terminate an EC2 instance",
 	Aliases: []string{"rm", "delete"},
-	Example: "asc ec2 terminate i-1234567890abcdef0",
+	Example: "asc ec2 terminate i-1234567890abcdef0\n" +
+		"asc ec2 terminate @",
 	GroupID: "actions",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(TerminateEC2Instance(cmd, args))
 	},
+	ValidArgsFunction: completion.InstanceIDs(0),
 }
 
-func newTerminateFlags(terminateCmd *cobra.Command) {}
+func newTerminateFlags(terminateCmd *cobra.Command) {
+	cmdutil.AddYesFlag(terminateCmd)
+}
 
 func init() {
+	cmdutil.AllowDryRun(terminateCmd)
 	newTerminateFlags(terminateCmd)
 }
 
@@ -35,18 +41,42 @@ func TerminateEC2Instance(cmd *cobra.Command, args []string) error {
 		cmd.Help()
 		return nil
 	}
+	ids, ok, err := cmdutil.ConfirmSelectedIDs(cmd, args, "ec2", "instance", "Terminate")
+	if err != nil || !ok {
+		return err
+	}
 
 	svc, err := ec2.NewEC2Service(ctx, profile, region)
 	if err != nil {
 		return fmt.Errorf("create new EC2 service: %w", err)
 	}
 
-	err = svc.TerminateInstance(ctx, &ascTypes.TerminateInstanceInput{
-		InstanceID: args[0],
-	})
-	if err != nil {
-		return fmt.Errorf("terminate instance: %w", err)
+	dryRun := cmdutil.IsDryRun(cmd)
+	var plan []cmdutil.Change
+	for _, id := range ids {
+		before, err := svc.GetInstanceStatus(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get instance state %s: %w", id, err)
+		}
+
+		err = svc.TerminateInstance(ctx, &ascTypes.TerminateInstanceInput{
+			InstanceID: id,
+			DryRun:     dryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("terminate instance %s: %w", id, err)
+		}
+
+		if dryRun {
+			plan = append(plan, stateChange(id, before, "terminated"))
+			continue
+		}
+		recordStateChange(cmd, id, "", before)
 	}
 
-	return ListEC2Instances(cmd, []string{args[0]})
+	if dryRun {
+		cmdutil.RenderPlan(plan)
+		return nil
+	}
+	return ListEC2Instances(cmd, ids)
 }
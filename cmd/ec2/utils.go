@@ -25,3 +25,14 @@ func getInstances(ctx context.Context, svc *ec2.EC2Service, args []string) ([]ty
 		InstanceIDs: args,
 	})
 }
+
+// stateChange returns the dry-run plan change for changing the state of an instance.
+func stateChange(instanceID string, before string, after string) cmdutil.Change {
+	return cmdutil.Change{Resource: instanceID, Field: "State", Before: before, After: after}
+}
+
+// recordStateChange records a change to the state of an instance in the journal. undo names the
+// handler that reverses it, or is empty if it cannot be undone.
+func recordStateChange(cmd *cobra.Command, instanceID string, undo string, before string) {
+	cmdutil.RecordChange(cmd, "ec2://instance/"+instanceID, undo, map[string]string{"state": before})
+}
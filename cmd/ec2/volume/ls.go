@@ -2,6 +2,8 @@
 package volume
 
 import (
+	"context"
+
 	"github.com/harleymckenzie/asc/internal/service/ec2"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ec2/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -13,11 +15,6 @@ import (
 // Variables
 var (
 	list           bool
-	sortType       bool
-	sortState      bool
-	sortSize       bool
-	sortAttachTime bool
-	sortCreatedAt  bool
 	showKMS        bool
 	showCreatedAt  bool
 	showAttachTime bool
@@ -33,15 +30,15 @@ func init() {
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
 		{Name: "Volume ID", Category: "Volume Details", Visible: true, SortBy: true, SortDirection: tablewriter.Asc},
-		{Name: "Type", Category: "Volume Details", Visible: true, SortBy: sortType, SortDirection: tablewriter.Asc},
+		{Name: "Type", Category: "Volume Details", Visible: true},
 		{Name: "Size", Category: "Volume Details", Visible: true},
-		{Name: "Size Raw", Category: "Volume Details", Visible: false, SortBy: sortSize, SortDirection: tablewriter.Desc},
+		{Name: "Size Raw", Category: "Volume Details", Visible: false},
 		{Name: "IOPS", Category: "Volume Details", Visible: true},
 		{Name: "Throughput", Category: "Volume Details", Visible: true},
 		{Name: "Snapshot ID", Category: "Volume Details", Visible: true},
-		{Name: "State", Category: "Volume Details", Visible: true, SortBy: sortState, SortDirection: tablewriter.Asc},
-		{Name: "Created", Category: "Volume Details", Visible: showCreatedAt, DefaultSort: true, SortBy: sortCreatedAt, SortDirection: tablewriter.Desc},
-		{Name: "Attach Time", Category: "Volume Details", Visible: showAttachTime, SortBy: sortAttachTime, SortDirection: tablewriter.Desc},
+		{Name: "State", Category: "Volume Details", Visible: true},
+		{Name: "Created", Category: "Volume Details", Visible: showCreatedAt, DefaultSort: true, SortDirection: tablewriter.Desc},
+		{Name: "Attach Time", Category: "Volume Details", Visible: showAttachTime},
 		{Name: "Availability Zone", Category: "Volume Details", Visible: false},
 		{Name: "Encryption", Category: "Volume Details", Visible: true},
 		{Name: "Fast Snapshot Restored", Category: "Volume Details", Visible: false},
@@ -64,16 +61,16 @@ var lsCmd = &cobra.Command{
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().
 		BoolVarP(&list, "list", "l", false, "Outputs volumes in list format.")
-	cobraCmd.Flags().BoolVarP(&sortType, "sort-type", "T", false, "Sort by descending volume type.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cmdutil.AddSortAlias(cobraCmd, "sort-type", "T", "Type")
 	cobraCmd.Flags().BoolVarP(&showKMS, "show-kms", "K", false, "Show the KMS Key ID column.")
-	cobraCmd.Flags().
-		BoolVarP(&sortState, "sort-state", "S", false, "Sort by descending volume state.")
-	cobraCmd.Flags().
-		BoolVarP(&sortAttachTime, "sort-attach-time", "a", false, "Sort by descending attach time.")
-	cobraCmd.Flags().
-		BoolVarP(&sortSize, "sort-size", "s", false, "Sort by descending size.")
-	cobraCmd.Flags().
-		BoolVarP(&sortCreatedAt, "sort-created-at", "t", false, "Sort by descending creation time.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-state", "S", "State")
+	cmdutil.AddSortAlias(cobraCmd, "sort-attach-time", "a", "Attach Time:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-size", "s", "Size:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-created-at", "t", "Created:desc")
 	cobraCmd.Flags().
 		BoolVarP(&showAttachTime, "show-attach-time", "A", false, "Show the attach time column.")
 	cobraCmd.Flags().
@@ -83,25 +80,35 @@ func NewLsFlags(cobraCmd *cobra.Command) {
 
 // ListVolumes is the handler for the ls subcommand.
 func ListVolumes(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, ec2.NewEC2Service)
+	filters, err := tablewriter.ParseFilters(cmdutil.Filters)
 	if err != nil {
 		return err
 	}
 
-	volumes, err := svc.GetVolumes(cmd.Context(), &ascTypes.GetVolumesInput{})
+	results, err := cmdutil.FanOut(cmd, ec2.NewEC2Service, func(ctx context.Context, svc *ec2.EC2Service) ([]any, error) {
+		volumes, err := svc.GetVolumes(ctx, &ascTypes.GetVolumesInput{
+			Filters: ec2.VolumeFilters(filters),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return utils.SlicesToAny(volumes), nil
+	})
 	if err != nil {
 		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Volumes",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(volumes),
-		GetFieldValue: ec2.GetFieldValue,
-		GetTagValue:   ec2.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ec2.GetFieldValue),
+		GetTagValue:   results.TagGetter(ec2.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
@@ -1,12 +1,17 @@
 package ec2
 
 import (
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/cmd/wait"
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/spf13/cobra"
 )
 
+func init() {
+	wait.AddFlags(waitCmd)
+}
+
 var waitCmd = &cobra.Command{
 	Use:     "wait <instance-id>",
 	Short:   "Wait for an EC2 instance to reach a stable state",
@@ -15,6 +20,7 @@ var waitCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(WaitEC2Instance(cmd, args))
 	},
+	ValidArgsFunction: completion.InstanceIDs(1),
 }
 
 func WaitEC2Instance(cmd *cobra.Command, args []string) error {
@@ -1,8 +1,10 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ecs"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
@@ -13,9 +15,6 @@ import (
 var (
 	list        bool
 	reverseSort bool
-
-	sortName   bool
-	sortStatus bool
 )
 
 func init() {
@@ -24,8 +23,8 @@ func init() {
 
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Name", Category: "Cluster", Visible: true, DefaultSort: true, SortBy: sortName, SortDirection: tablewriter.Asc},
-		{Name: "Status", Category: "Cluster", Visible: true, SortBy: sortStatus, SortDirection: tablewriter.Asc},
+		{Name: "Name", Category: "Cluster", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
+		{Name: "Status", Category: "Cluster", Visible: true},
 		{Name: "Active Services", Category: "Cluster", Visible: true},
 		{Name: "Running Tasks", Category: "Cluster", Visible: true},
 		{Name: "Pending Tasks", Category: "Cluster", Visible: true},
@@ -45,10 +44,14 @@ var lsCmd = &cobra.Command{
 
 func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs in list format.")
-	cmdutil.AddTagFlag(cobraCmd)
+	cmdutil.AddTagFlag(cobraCmd, completion.ECSClusterTagKeys())
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 
-	cobraCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by cluster name.")
-	cobraCmd.Flags().BoolVarP(&sortStatus, "sort-status", "s", false, "Sort by cluster status.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "Name")
+	cmdutil.AddSortAlias(cobraCmd, "sort-status", "s", "Status")
 	cobraCmd.MarkFlagsMutuallyExclusive("sort-name", "sort-status")
 
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
@@ -56,25 +59,28 @@ func newLsFlags(cobraCmd *cobra.Command) {
 }
 
 func ListClusters(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, ecs.NewECSService)
-	if err != nil {
-		return fmt.Errorf("create ECS service: %w", err)
-	}
-
-	clusters, err := svc.GetAllClusters(cmd.Context())
+	results, err := cmdutil.FanOut(cmd, ecs.NewECSService, func(ctx context.Context, svc *ecs.ECSService) ([]any, error) {
+		clusters, err := svc.GetAllClusters(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list ECS clusters: %w", err)
+		}
+		return utils.SlicesToAny(clusters), nil
+	})
 	if err != nil {
-		return fmt.Errorf("list ECS clusters: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "ECS Clusters",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(clusters),
-		GetFieldValue: ecs.GetFieldValue,
-		GetTagValue:   ecs.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ecs.GetFieldValue),
+		GetTagValue:   results.TagGetter(ecs.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ecs"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ecs/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -41,6 +42,7 @@ var showCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ShowCluster(cmd, args[0]))
 	},
+	ValidArgsFunction: completion.ECSClusters(1),
 }
 
 func NewShowFlags(cmd *cobra.Command) {
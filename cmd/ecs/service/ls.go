@@ -1,9 +1,12 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ecs"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 	"github.com/harleymckenzie/asc/internal/shared/utils"
@@ -14,12 +17,10 @@ var (
 	list        bool
 	reverseSort bool
 	cluster     string
+	concurrency int
 
 	showARN         bool
 	showCreatedDate bool
-
-	sortName   bool
-	sortStatus bool
 )
 
 func init() {
@@ -28,8 +29,8 @@ func init() {
 
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Name", Category: "Service", Visible: true, DefaultSort: true, SortBy: sortName, SortDirection: tablewriter.Asc},
-		{Name: "Status", Category: "Service", Visible: true, SortBy: sortStatus, SortDirection: tablewriter.Asc},
+		{Name: "Name", Category: "Service", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
+		{Name: "Status", Category: "Service", Visible: true},
 		{Name: "Launch Type", Category: "Service", Visible: true},
 		{Name: "Task Definition", Category: "Service", Visible: true},
 		{Name: "Desired Count", Category: "Service", Visible: true},
@@ -52,12 +53,18 @@ var lsCmd = &cobra.Command{
 func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs in list format.")
 	cobraCmd.Flags().StringVarP(&cluster, "cluster", "c", "", "Filter services by cluster name or ARN.")
+	completion.RegisterFlag(cobraCmd, "cluster", completion.ECSClusterFlag())
 	cobraCmd.Flags().BoolVarP(&showARN, "arn", "a", false, "Show service ARN.")
 	cobraCmd.Flags().BoolVarP(&showCreatedDate, "created-date", "d", false, "Show created date.")
-	cmdutil.AddTagFlag(cobraCmd)
+	cmdutil.AddTagFlag(cobraCmd, completion.ECSServiceTagKeys())
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cobraCmd.Flags().IntVar(&concurrency, "concurrency", awsutil.DefaultConcurrency, "Maximum number of clusters and batches of services to describe at a time.")
 
-	cobraCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by service name.")
-	cobraCmd.Flags().BoolVarP(&sortStatus, "sort-status", "s", false, "Sort by service status.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "Name")
+	cmdutil.AddSortAlias(cobraCmd, "sort-status", "s", "Status")
 	cobraCmd.MarkFlagsMutuallyExclusive("sort-name", "sort-status")
 
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
@@ -65,25 +72,32 @@ func newLsFlags(cobraCmd *cobra.Command) {
 }
 
 func ListServices(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, ecs.NewECSService)
-	if err != nil {
-		return fmt.Errorf("create ECS service: %w", err)
+	if concurrency < 1 {
+		return fmt.Errorf("invalid value for concurrency flag: %d. Must be 1 or greater", concurrency)
 	}
-
-	services, err := svc.GetAllServices(cmd.Context(), cluster)
+	results, err := cmdutil.FanOut(cmd, ecs.NewECSService, func(ctx context.Context, svc *ecs.ECSService) ([]any, error) {
+		svc.Concurrency = concurrency
+		services, err := svc.GetAllServices(ctx, cluster)
+		if err != nil {
+			return nil, fmt.Errorf("list ECS services: %w", err)
+		}
+		return utils.SlicesToAny(services), nil
+	})
 	if err != nil {
-		return fmt.Errorf("list ECS services: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "ECS Services",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(services),
-		GetFieldValue: ecs.GetFieldValue,
-		GetTagValue:   ecs.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ecs.GetFieldValue),
+		GetTagValue:   results.TagGetter(ecs.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ecs"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ecs/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -54,22 +55,30 @@ var showCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ShowService(cmd, args[0]))
 	},
+	ValidArgsFunction: completion.ECSServices(1),
 }
 
 func NewShowFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&showCluster, "cluster", "c", "", "Cluster name or ARN (required).")
 	cmd.MarkFlagRequired("cluster")
+	completion.RegisterFlag(cmd, "cluster", completion.ECSClusterFlag())
 	cmdutil.AddShowFlags(cmd, "vertical")
 }
 
+// ShowService displays the service from the cluster given by the --cluster flag.
 func ShowService(cmd *cobra.Command, serviceName string) error {
+	return ShowServiceInCluster(cmd, showCluster, serviceName)
+}
+
+// ShowServiceInCluster displays detailed information about a service in the given cluster.
+func ShowServiceInCluster(cmd *cobra.Command, cluster string, serviceName string) error {
 	svc, err := cmdutil.CreateService(cmd, ecs.NewECSService)
 	if err != nil {
 		return fmt.Errorf("create ECS service: %w", err)
 	}
 
 	services, err := svc.DescribeServices(cmd.Context(), &ascTypes.DescribeServicesInput{
-		Cluster:  showCluster,
+		Cluster:  cluster,
 		Services: []string{serviceName},
 	})
 	if err != nil {
@@ -1,9 +1,12 @@
 package task
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ecs"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 	"github.com/harleymckenzie/asc/internal/shared/utils"
@@ -15,8 +18,7 @@ var (
 	reverseSort bool
 	cluster     string
 	serviceName string
-
-	sortStatus bool
+	concurrency int
 )
 
 func init() {
@@ -27,7 +29,7 @@ func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
 		{Name: "Task ID", Category: "Task", Visible: true, DefaultSort: true},
 		{Name: "Service", Category: "Task", Visible: serviceName == ""},
-		{Name: "Status", Category: "Task", Visible: true, SortBy: sortStatus, SortDirection: tablewriter.Asc},
+		{Name: "Status", Category: "Task", Visible: true},
 		{Name: "Desired Status", Category: "Task", Visible: true},
 		{Name: "Task Definition", Category: "Task", Visible: true},
 		{Name: "Created At", Category: "Task", Visible: true},
@@ -50,35 +52,48 @@ var lsCmd = &cobra.Command{
 func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs in list format.")
 	cobraCmd.Flags().StringVarP(&cluster, "cluster", "c", "", "Filter tasks by cluster name or ARN.")
+	completion.RegisterFlag(cobraCmd, "cluster", completion.ECSClusterFlag())
 	cobraCmd.Flags().StringVarP(&serviceName, "service", "S", "", "Filter tasks by service name.")
-	cmdutil.AddTagFlag(cobraCmd)
+	cmdutil.AddTagFlag(cobraCmd, completion.ECSTaskTagKeys())
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cobraCmd.Flags().IntVar(&concurrency, "concurrency", awsutil.DefaultConcurrency, "Maximum number of clusters and batches of tasks to describe at a time.")
 
-	cobraCmd.Flags().BoolVarP(&sortStatus, "sort-status", "s", false, "Sort by task status.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-status", "s", "Status")
 
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
 	cobraCmd.Flags().SortFlags = false
 }
 
 func ListTasks(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, ecs.NewECSService)
-	if err != nil {
-		return fmt.Errorf("create ECS service: %w", err)
+	if concurrency < 1 {
+		return fmt.Errorf("invalid value for concurrency flag: %d. Must be 1 or greater", concurrency)
 	}
-
-	tasks, err := svc.GetAllTasks(cmd.Context(), cluster, serviceName)
+	results, err := cmdutil.FanOut(cmd, ecs.NewECSService, func(ctx context.Context, svc *ecs.ECSService) ([]any, error) {
+		svc.Concurrency = concurrency
+		tasks, err := svc.GetAllTasks(ctx, cluster, serviceName)
+		if err != nil {
+			return nil, fmt.Errorf("list ECS tasks: %w", err)
+		}
+		return utils.SlicesToAny(tasks), nil
+	})
 	if err != nil {
-		return fmt.Errorf("list ECS tasks: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "ECS Tasks",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(tasks),
-		GetFieldValue: ecs.GetFieldValue,
-		GetTagValue:   ecs.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ecs.GetFieldValue),
+		GetTagValue:   results.TagGetter(ecs.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
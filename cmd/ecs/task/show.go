@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ecs"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ecs/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -60,17 +61,24 @@ var showCmd = &cobra.Command{
 func NewShowFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&showCluster, "cluster", "c", "", "Cluster name or ARN (required).")
 	cmd.MarkFlagRequired("cluster")
+	completion.RegisterFlag(cmd, "cluster", completion.ECSClusterFlag())
 	cmdutil.AddShowFlags(cmd, "vertical")
 }
 
+// ShowTask displays the task from the cluster given by the --cluster flag.
 func ShowTask(cmd *cobra.Command, taskID string) error {
+	return ShowTaskInCluster(cmd, showCluster, taskID)
+}
+
+// ShowTaskInCluster displays detailed information about a task in the given cluster.
+func ShowTaskInCluster(cmd *cobra.Command, cluster string, taskID string) error {
 	svc, err := cmdutil.CreateService(cmd, ecs.NewECSService)
 	if err != nil {
 		return fmt.Errorf("create ECS service: %w", err)
 	}
 
 	tasks, err := svc.DescribeTasks(cmd.Context(), &ascTypes.DescribeTasksInput{
-		Cluster: showCluster,
+		Cluster: cluster,
 		Tasks:   []string{taskID},
 	})
 	if err != nil {
@@ -1,6 +1,7 @@
 package task
 
 import (
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/cmd/wait"
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -16,6 +17,8 @@ func init() {
 func newWaitFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().StringVarP(&waitCluster, "cluster", "c", "", "Cluster name or ARN (required).")
 	cobraCmd.MarkFlagRequired("cluster")
+	completion.RegisterFlag(cobraCmd, "cluster", completion.ECSClusterFlag())
+	wait.AddFlags(cobraCmd)
 }
 
 var waitCmd = &cobra.Command{
@@ -1,6 +1,7 @@
 package taskdefinition
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -52,78 +53,88 @@ var lsCmd = &cobra.Command{
 
 func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getFamilyListFields, getRevisionListFields)
+	cmdutil.AddSortFlag(cobraCmd, getFamilyListFields, getRevisionListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getFamilyListFields, getRevisionListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
 	cobraCmd.Flags().SortFlags = false
 }
 
 func ListTaskDefinitionFamilies(cmd *cobra.Command) error {
-	svc, err := cmdutil.CreateService(cmd, ecs.NewECSService)
-	if err != nil {
-		return fmt.Errorf("create ECS service: %w", err)
-	}
+	results, err := cmdutil.FanOut(cmd, ecs.NewECSService, func(ctx context.Context, svc *ecs.ECSService) ([]any, error) {
+		families, err := svc.ListTaskDefinitionFamilies(ctx, &ascTypes.ListTaskDefinitionFamiliesInput{})
+		if err != nil {
+			return nil, fmt.Errorf("list task definition families: %w", err)
+		}
 
-	families, err := svc.ListTaskDefinitionFamilies(cmd.Context(), &ascTypes.ListTaskDefinitionFamiliesInput{})
+		// Convert to TaskDefinitionFamily structs
+		var data []ecs.TaskDefinitionFamily
+		for _, f := range families {
+			data = append(data, ecs.TaskDefinitionFamily{Name: f})
+		}
+		return utils.SlicesToAny(data), nil
+	})
 	if err != nil {
-		return fmt.Errorf("list task definition families: %w", err)
-	}
-
-	// Convert to TaskDefinitionFamily structs
-	var data []ecs.TaskDefinitionFamily
-	for _, f := range families {
-		data = append(data, ecs.TaskDefinitionFamily{Name: f})
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Task Definition Families",
 		PlainStyle:    list,
-		Fields:        getFamilyListFields(),
-		Data:          utils.SlicesToAny(data),
-		GetFieldValue: ecs.GetFieldValue,
-		GetTagValue:   ecs.GetTagValue,
+		Fields:        results.Fields(getFamilyListFields()),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ecs.GetFieldValue),
+		GetTagValue:   results.TagGetter(ecs.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
 
 func ListTaskDefinitionRevisions(cmd *cobra.Command, familyName string) error {
-	svc, err := cmdutil.CreateService(cmd, ecs.NewECSService)
-	if err != nil {
-		return fmt.Errorf("create ECS service: %w", err)
-	}
+	results, err := cmdutil.FanOut(cmd, ecs.NewECSService, func(ctx context.Context, svc *ecs.ECSService) ([]any, error) {
+		arns, err := svc.ListTaskDefinitionRevisions(ctx, &ascTypes.ListTaskDefinitionRevisionsInput{
+			FamilyName: familyName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list task definition revisions: %w", err)
+		}
 
-	arns, err := svc.ListTaskDefinitionRevisions(cmd.Context(), &ascTypes.ListTaskDefinitionRevisionsInput{
-		FamilyName: familyName,
+		// Convert ARNs to TaskDefinitionRevision structs
+		var data []ecs.TaskDefinitionRevision
+		for _, arn := range arns {
+			shortName := ecs.ShortARN(arn)
+			parts := strings.SplitN(shortName, ":", 2)
+			family := shortName
+			revision := ""
+			if len(parts) == 2 {
+				family = parts[0]
+				revision = parts[1]
+			}
+			data = append(data, ecs.TaskDefinitionRevision{
+				ARN:      arn,
+				Family:   family,
+				Revision: revision,
+			})
+		}
+		return utils.SlicesToAny(data), nil
 	})
 	if err != nil {
-		return fmt.Errorf("list task definition revisions: %w", err)
-	}
-
-	// Convert ARNs to TaskDefinitionRevision structs
-	var data []ecs.TaskDefinitionRevision
-	for _, arn := range arns {
-		shortName := ecs.ShortARN(arn)
-		parts := strings.SplitN(shortName, ":", 2)
-		family := shortName
-		revision := ""
-		if len(parts) == 2 {
-			family = parts[0]
-			revision = parts[1]
-		}
-		data = append(data, ecs.TaskDefinitionRevision{
-			ARN:      arn,
-			Family:   family,
-			Revision: revision,
-		})
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         fmt.Sprintf("Task Definition Revisions (%s)", familyName),
 		PlainStyle:    list,
-		Fields:        getRevisionListFields(),
-		Data:          utils.SlicesToAny(data),
-		GetFieldValue: ecs.GetFieldValue,
-		GetTagValue:   ecs.GetTagValue,
+		Fields:        results.Fields(getRevisionListFields()),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(ecs.GetFieldValue),
+		GetTagValue:   results.TagGetter(ecs.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
@@ -1,8 +1,10 @@
 package efs
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/efs"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
@@ -46,30 +48,37 @@ var lsCmd = &cobra.Command{
 
 func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs file systems in list format.")
-	cmdutil.AddTagFlag(cobraCmd)
+	cmdutil.AddTagFlag(cobraCmd, completion.FileSystemTagKeys())
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
 }
 
 func ListFileSystems(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, efs.NewEFSService)
-	if err != nil {
-		return fmt.Errorf("create efs service: %w", err)
-	}
-
-	fileSystems, err := svc.GetFileSystems(cmd.Context())
+	results, err := cmdutil.FanOut(cmd, efs.NewEFSService, func(ctx context.Context, svc *efs.EFSService) ([]any, error) {
+		fileSystems, err := svc.GetFileSystems(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get file systems: %w", err)
+		}
+		return utils.SlicesToAny(fileSystems), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get file systems: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "File Systems",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(fileSystems),
-		GetFieldValue: efs.GetFieldValue,
-		GetTagValue:   efs.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(efs.GetFieldValue),
+		GetTagValue:   results.TagGetter(efs.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
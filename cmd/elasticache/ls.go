@@ -3,6 +3,7 @@
 package elasticache
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/elasticache"
@@ -17,10 +18,6 @@ var (
 	list         bool
 	showEndpoint bool
 
-	sortType   bool
-	sortStatus bool
-	sortEngine bool
-
 	reverseSort bool
 )
 
@@ -33,9 +30,9 @@ func init() {
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
 		{Name: "Cache Name", Category: "Cluster Details", Visible: true, DefaultSort: true},
-		{Name: "Status", Category: "Cluster Details", Visible: true, SortBy: sortStatus, SortDirection: tablewriter.Asc},
-		{Name: "Engine Version", Category: "Cluster Details", Visible: true, SortBy: sortEngine, SortDirection: tablewriter.Desc},
-		{Name: "Configuration", Category: "Cluster Details", Visible: true, SortBy: sortType, SortDirection: tablewriter.Asc},
+		{Name: "Status", Category: "Cluster Details", Visible: true},
+		{Name: "Engine Version", Category: "Cluster Details", Visible: true},
+		{Name: "Configuration", Category: "Cluster Details", Visible: true},
 		{Name: "Endpoint", Category: "Network", Visible: showEndpoint},
 	}
 }
@@ -52,38 +49,45 @@ var lsCmd = &cobra.Command{
 
 // ListElasticacheClusters is the function for listing Elasticache clusters
 func ListElasticacheClusters(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, elasticache.NewElasticacheService)
-	if err != nil {
-		return fmt.Errorf("create elasticache service: %w", err)
-	}
-
-	instances, err := svc.GetInstances(cmd.Context())
+	results, err := cmdutil.FanOut(cmd, elasticache.NewElasticacheService, func(ctx context.Context, svc *elasticache.ElasticacheService) ([]any, error) {
+		instances, err := svc.GetInstances(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get instances: %w", err)
+		}
+		return utils.SlicesToAny(instances), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get instances: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Elasticache Clusters",
 		PlainStyle:    list,
-		Fields:        getListFields(),
-		Data:          utils.SlicesToAny(instances),
-		GetFieldValue: elasticache.GetFieldValue,
-		GetTagValue:   elasticache.GetTagValue,
+		Fields:        results.Fields(getListFields()),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(elasticache.GetFieldValue),
+		GetTagValue:   results.TagGetter(elasticache.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
 
 // Flag function
 func newLsFlags(cobraCmd *cobra.Command) {
 	// Add flags - Output
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs Elasticache clusters in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&showEndpoint, "endpoint", "e", false, "Show the endpoint of the cluster")
 
 	// Add flags - Sorting
-	cobraCmd.Flags().BoolVarP(&sortType, "sort-type", "T", false, "Sort by descending Elasticache cluster type.")
-	cobraCmd.Flags().BoolVarP(&sortStatus, "sort-status", "s", false, "Sort by descending Elasticache cluster status.")
-	cobraCmd.Flags().BoolVarP(&sortEngine, "sort-engine", "E", false, "Sort by descending Elasticache cluster engine version.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-type", "T", "Configuration")
+	cmdutil.AddSortAlias(cobraCmd, "sort-status", "s", "Status")
+	cmdutil.AddSortAlias(cobraCmd, "sort-engine", "E", "Engine Version:desc")
 	cobraCmd.MarkFlagsMutuallyExclusive("sort-type", "sort-status", "sort-engine")
 
 	// Add flags - Reverse Sort
@@ -7,6 +7,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+func init() {
+	wait.AddFlags(waitCmd)
+}
+
 var waitCmd = &cobra.Command{
 	Use:     "wait <cluster-id>",
 	Short:   "Wait for an ElastiCache cluster to reach a stable state",
@@ -3,6 +3,7 @@
 package elb
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/elb"
@@ -23,12 +24,6 @@ var (
 	showAZs           bool
 	showIPAddressType bool
 
-	sortDNSName     bool
-	sortType        bool
-	sortCreatedTime bool
-	sortScheme      bool
-	sortVPCID       bool
-
 	reverseSort bool
 )
 
@@ -41,13 +36,13 @@ func init() {
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
 		{Name: "Name", Category: "Load Balancer Details", Visible: true, DefaultSort: true},
-		{Name: "DNS Name", Category: "Network", Visible: showDNSName, SortBy: sortDNSName, SortDirection: tablewriter.Asc},
-		{Name: "Scheme", Category: "Load Balancer Details", Visible: showScheme, SortBy: sortScheme, SortDirection: tablewriter.Asc},
+		{Name: "DNS Name", Category: "Network", Visible: showDNSName},
+		{Name: "Scheme", Category: "Load Balancer Details", Visible: showScheme},
 		{Name: "State", Category: "Load Balancer Details", Visible: true},
-		{Name: "Type", Category: "Load Balancer Details", Visible: true, SortBy: sortType, SortDirection: tablewriter.Asc},
+		{Name: "Type", Category: "Load Balancer Details", Visible: true},
 		{Name: "IP Type", Category: "Network", Visible: showIPAddressType},
-		{Name: "VPC ID", Category: "Network", Visible: true, SortBy: sortVPCID, SortDirection: tablewriter.Asc},
-		{Name: "Created Time", Category: "Load Balancer Details", Visible: true, SortBy: sortCreatedTime, SortDirection: tablewriter.Desc},
+		{Name: "VPC ID", Category: "Network", Visible: true},
+		{Name: "Created Time", Category: "Load Balancer Details", Visible: true},
 		{Name: "ARN", Category: "Load Balancer Details", Visible: showARNs},
 		{Name: "Availability Zones", Category: "Network", Visible: showAZs},
 	}
@@ -71,6 +66,10 @@ var lsCmd = &cobra.Command{
 func newLsFlags(cobraCmd *cobra.Command) {
 	// Output flags
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs Elastic Load Balancers in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&showARNs, "arn", "a", false, "Show ARNs for each Elastic Load Balancer.")
 	cobraCmd.Flags().BoolVarP(&showDNSName, "dns-name", "d", false, "Show the DNS name of the Elastic Load Balancer.")
 	cobraCmd.Flags().BoolVarP(&showScheme, "scheme", "s", false, "Show the scheme for each Elastic Load Balancer.")
@@ -78,34 +77,37 @@ func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&showIPAddressType, "ip-address-type", "i", false, "Show the IP address type for each Elastic Load Balancer.")
 
 	// Sorting flags
-	cobraCmd.Flags().BoolVarP(&sortDNSName, "sort-dns-name", "D", false, "Sort by descending DNS name.")
-	cobraCmd.Flags().BoolVarP(&sortType, "sort-type", "T", false, "Sort by descending Elastic Load Balancer type.")
-	cobraCmd.Flags().BoolVarP(&sortCreatedTime, "sort-created-time", "t", false, "Sort by descending date created.")
-	cobraCmd.Flags().BoolVarP(&sortScheme, "sort-scheme", "S", false, "Sort by descending scheme.")
-	cobraCmd.Flags().BoolVarP(&sortVPCID, "sort-vpc-id", "V", false, "Sort by descending VPC ID.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-dns-name", "D", "DNS Name")
+	cmdutil.AddSortAlias(cobraCmd, "sort-type", "T", "Type")
+	cmdutil.AddSortAlias(cobraCmd, "sort-created-time", "t", "Created Time:desc")
+	cmdutil.AddSortAlias(cobraCmd, "sort-scheme", "S", "Scheme")
+	cmdutil.AddSortAlias(cobraCmd, "sort-vpc-id", "V", "VPC ID")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
 }
 
 // Command functions
 func ListELBs(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, elb.NewELBService)
-	if err != nil {
-		return fmt.Errorf("create elb service: %w", err)
-	}
-
-	loadBalancers, err := svc.GetLoadBalancers(cmd.Context(), &ascTypes.GetLoadBalancersInput{})
+	results, err := cmdutil.FanOut(cmd, elb.NewELBService, func(ctx context.Context, svc *elb.ELBService) ([]any, error) {
+		loadBalancers, err := svc.GetLoadBalancers(ctx, &ascTypes.GetLoadBalancersInput{})
+		if err != nil {
+			return nil, fmt.Errorf("get load balancers: %w", err)
+		}
+		return utils.SlicesToAny(loadBalancers), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get load balancers: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Elastic Load Balancers",
 		PlainStyle:    list,
-		Fields:        getListFields(),
-		Data:          utils.SlicesToAny(loadBalancers),
-		GetFieldValue: elb.GetFieldValue,
-		GetTagValue:   elb.GetTagValue,
+		Fields:        results.Fields(getListFields()),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(elb.GetFieldValue),
+		GetTagValue:   results.TagGetter(elb.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
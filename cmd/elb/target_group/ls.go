@@ -1,6 +1,7 @@
 package target_group
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/elb"
@@ -50,6 +51,10 @@ var lsCmd = &cobra.Command{
 
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs target groups in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getTargetGroupFields)
+	cmdutil.AddSortFlag(cobraCmd, getTargetGroupFields)
+	cmdutil.AddFilterFlag(cobraCmd, getTargetGroupFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&showARNs, "arn", "a", false, "Show ARNs for each target group.")
 	cobraCmd.Flags().
 		BoolVarP(&showHealthCheckEnabled, "health-check-enabled", "e", false, "Show health check enabled for each target group.")
@@ -62,31 +67,34 @@ func NewLsFlags(cobraCmd *cobra.Command) {
 
 // ListELBTargetGroups lists all target groups for a given ELB
 func ListTargetGroups(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, elb.NewELBService)
-	if err != nil {
-		return fmt.Errorf("create elb service: %w", err)
-	}
-
 	input := &ascTypes.ListTargetGroupsInput{}
 	if len(args) > 0 {
 		input.Names = []string{args[0]}
 	}
 
-	targetGroups, err := svc.GetTargetGroups(cmd.Context(), &ascTypes.GetTargetGroupsInput{
-		ListTargetGroupsInput: *input,
+	results, err := cmdutil.FanOut(cmd, elb.NewELBService, func(ctx context.Context, svc *elb.ELBService) ([]any, error) {
+		targetGroups, err := svc.GetTargetGroups(ctx, &ascTypes.GetTargetGroupsInput{
+			ListTargetGroupsInput: *input,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get target groups: %w", err)
+		}
+		return utils.SlicesToAny(targetGroups), nil
 	})
 	if err != nil {
-		return fmt.Errorf("get target groups: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Target Groups",
 		PlainStyle:    list,
-		Fields:        getTargetGroupFields(),
-		Data:          utils.SlicesToAny(targetGroups),
-		GetFieldValue: elb.GetFieldValue,
-		GetTagValue:   elb.GetTagValue,
+		Fields:        results.Fields(getTargetGroupFields()),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(elb.GetFieldValue),
+		GetTagValue:   results.TagGetter(elb.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
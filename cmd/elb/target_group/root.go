@@ -1,8 +1,6 @@
 package target_group
 
 import (
-	"log"
-
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -13,17 +11,10 @@ func NewTargetGroupRootCmd() *cobra.Command {
 		Short:   "Perform target group operations",
 		Aliases: []string{"tg", "target-groups", "target_group", "target_groups"},
 		GroupID: "subcommands",
-		Run: func(cobraCmd *cobra.Command, args []string) {
-			if len(args) == 0 {
-				log.Fatalf("Please provide a subcommand: ls")
-			}
-
-			switch args[0] {
-			case "ls":
-				lsCmd.Run(cobraCmd, args[1:])
-			default:
-				log.Fatalf("Invalid subcommand: %s", args[0])
-			}
+		// Subcommands are run by cobra, so any other argument is an unknown subcommand
+		Args: cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cobraCmd.Help()
 		},
 	}
 
@@ -7,6 +7,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+func init() {
+	wait.AddFlags(waitCmd)
+}
+
 var waitCmd = &cobra.Command{
 	Use:     "wait <lb-name>",
 	Short:   "Wait for a load balancer to reach a stable state",
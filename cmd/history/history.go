@@ -0,0 +1,161 @@
+package history
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/journal"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	list        bool
+	limit       int
+	reverseSort bool
+)
+
+// item is a journal entry shown in the history, with the ID of the entry that undid it.
+type item struct {
+	journal.Entry
+	undoneBy int
+}
+
+func getListFields() []tablewriter.Field {
+	return []tablewriter.Field{
+		{Name: "ID", Category: "Change", Visible: true, DefaultSort: true},
+		{Name: "Time", Category: "Change", Visible: true},
+		{Name: "Profile", Category: "Change", Visible: true},
+		{Name: "Region", Category: "Change", Visible: true},
+		{Name: "Role ARN", Category: "Change"},
+		{Name: "Endpoint URL", Category: "Change"},
+		{Name: "Command", Category: "Change", Visible: true},
+		{Name: "Target", Category: "Change", Visible: true},
+		{Name: "Before", Category: "Change", Visible: true},
+		{Name: "Undo", Category: "Change", Visible: true},
+	}
+}
+
+// NewHistoryCmd creates the history command.
+func NewHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List changes made by asc",
+		Long: `List the changes made by asc commands, from the local journal. Each change records
+when and where it was made, the command that made it, the resource it changed and the
+state of the resource before the change.
+
+The Undo column shows whether a change can be reversed with 'asc undo <id>'.
+
+The journal is stored in $XDG_STATE_HOME/asc/journal.jsonl, or
+~/.local/state/asc/journal.jsonl. Set ASC_JOURNAL to use a different file.`,
+		Example: `  asc history             # List the last 20 changes
+  asc history -n 0        # List all changes
+  asc history --filter Profile=prod
+  asc history --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(ListHistory(cmd, args))
+		},
+	}
+	cmd.Flags().BoolVarP(&list, "list", "l", false, "Output changes in list format.")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 20, "Number of most recent changes to list (0 for all)")
+	cmdutil.AddColumnsFlag(cmd, getListFields)
+	cmdutil.AddSortFlag(cmd, getListFields)
+	cmdutil.AddFilterFlag(cmd, getListFields)
+	cmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
+	return cmd
+}
+
+// ListHistory lists the most recent entries in the journal.
+func ListHistory(cmd *cobra.Command, args []string) error {
+	if limit < 0 {
+		return fmt.Errorf("invalid value for limit flag: %d. Must be 0 or greater", limit)
+	}
+	entries, err := journal.Load()
+	if err != nil {
+		return err
+	}
+
+	undone := journal.UndoneBy(entries)
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	var data []any
+	for _, entry := range entries {
+		data = append(data, item{Entry: entry, undoneBy: undone[entry.ID]})
+	}
+
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
+		Title:         "History",
+		PlainStyle:    list,
+		Fields:        getListFields(),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          data,
+		GetFieldValue: getFieldValue,
+		GetTagValue:   getTagValue,
+		ReverseSort:   reverseSort,
+	})
+}
+
+// getFieldValue returns the value of a field for a history item.
+func getFieldValue(fieldName string, instance any) (string, error) {
+	h, ok := instance.(item)
+	if !ok {
+		return "", fmt.Errorf("unsupported type: %T", instance)
+	}
+
+	switch fieldName {
+	case "ID":
+		return strconv.Itoa(h.ID), nil
+	case "Time":
+		return h.Time.Local().Format("2006-01-02 15:04:05 MST"), nil
+	case "Profile":
+		return h.Profile, nil
+	case "Region":
+		return h.Region, nil
+	case "Role ARN":
+		return h.RoleARN, nil
+	case "Endpoint URL":
+		return h.Endpoint, nil
+	case "Command":
+		return h.Command, nil
+	case "Target":
+		return h.Target, nil
+	case "Before":
+		var pairs []string
+		for _, key := range slices.Sorted(maps.Keys(h.Before)) {
+			pairs = append(pairs, key+"="+h.Before[key])
+		}
+		return strings.Join(pairs, ", "), nil
+	case "Undo":
+		return undoStatus(h), nil
+	default:
+		return "", fmt.Errorf("unknown field: %s", fieldName)
+	}
+}
+
+// undoStatus describes whether a change can be undone.
+func undoStatus(h item) string {
+	switch {
+	case h.undoneBy != 0:
+		return fmt.Sprintf("Undone by %d", h.undoneBy)
+	case h.Undo == "":
+		return "Not supported"
+	case h.UndoOf != 0:
+		return fmt.Sprintf("Undo of %d", h.UndoOf)
+	default:
+		return "Available"
+	}
+}
+
+// getTagValue is a no-op for history items, which don't have tags.
+func getTagValue(tagKey string, instance any) (string, error) {
+	return "", nil
+}
@@ -35,6 +35,14 @@ var lsCmd = &cobra.Command{
 func newLsFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&tree, "tree", "t", false, "Display as a tree of OUs and accounts")
 	cmd.Flags().BoolVarP(&showOUPath, "ou-path", "P", false, "Show full OU path instead of direct parent OU")
+	cmdutil.AddColumnsFlag(cmd, accountListFields)
+	cmdutil.AddSortFlag(cmd, accountListFields)
+	cmdutil.AddFilterFlag(cmd, accountListFields)
+}
+
+// accountListFields returns the fields for the account list table.
+func accountListFields() []tablewriter.Field {
+	return organizations.AccountListFields(showOUPath)
 }
 
 func listOrganizations(cmd *cobra.Command, args []string) error {
@@ -55,14 +63,16 @@ func listTable(cmd *cobra.Command, svc *organizations.OrganizationsService) erro
 		return fmt.Errorf("list accounts: %w", err)
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Accounts",
 		Style:         "rounded-separated",
-		Fields:        organizations.AccountListFields(showOUPath),
+		Fields:        accountListFields(),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(accounts),
 		GetFieldValue: organizations.GetFieldValue,
 	})
-	return nil
 }
 
 func listTree(cmd *cobra.Command, svc *organizations.OrganizationsService) error {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/profile"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 	"github.com/harleymckenzie/asc/internal/shared/utils"
 	"github.com/spf13/cobra"
@@ -54,22 +55,27 @@ var lsCmd = &cobra.Command{
 			return fmt.Errorf("list profiles: %w", err)
 		}
 
-		tablewriter.RenderList(tablewriter.RenderListOptions{
+		return tablewriter.RenderList(tablewriter.RenderListOptions{
 			Title:         "Profiles",
 			PlainStyle:    list,
 			Fields:        getListFields(),
+			Columns:       cmdutil.Columns,
+			Sort:          cmdutil.Sort,
+			Filters:       cmdutil.Filters,
 			Data:          utils.SlicesToAny(profiles),
 			GetFieldValue: profile.GetFieldValue,
 			GetTagValue:   profile.GetTagValue,
 			ReverseSort:   reverseSort,
 			HideEmpty:     true,
 		})
-		return nil
 	},
 }
 
 func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Output profiles in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
 	cobraCmd.Flags().BoolVarP(&showAll, "all", "a", false, "Include SSO session entries.")
 	cobraCmd.Flags().BoolVarP(&showSSO, "sso", "s", false, "Show SSO configuration details.")
 	cobraCmd.Flags().BoolVarP(&showRole, "role", "R", false, "Show role assumption details.")
@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/rds"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/rds/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -18,11 +20,13 @@ var ()
 // Init function
 func init() {
 	newCancelPendingModificationsFlags(cancelPendingModificationsCmd)
+	cmdutil.BypassCache(cancelPendingModificationsCmd)
 }
 
 // Flag function
 func newCancelPendingModificationsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().SortFlags = false
+	cmdutil.AllowDryRun(cobraCmd)
 }
 
 // Command variable
@@ -37,6 +41,7 @@ var cancelPendingModificationsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(CancelPendingModifications(cmd, args))
 	},
+	ValidArgsFunction: completion.DBInstances(1),
 }
 
 // Command functions
@@ -74,6 +79,16 @@ func CancelPendingModifications(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("instance class not found")
 	}
 
+	if cmdutil.IsDryRun(cmd) {
+		// Only the pending instance class is replaced by the current one
+		cmdutil.RenderPlan([]cmdutil.Change{{
+			Resource: args[0],
+			Field:    "Pending Instance Class",
+			Before:   aws.ToString(instance[0].PendingModifiedValues.DBInstanceClass),
+		}})
+		return nil
+	}
+
 	// Cancel pending modifications
 	err = svc.ModifyInstance(ctx, &ascTypes.ModifyInstanceInput{
 		DBInstanceIdentifier: &args[0],
@@ -83,6 +98,7 @@ func CancelPendingModifications(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("cancel pending modifications: %w", err)
 	}
+	cmdutil.RecordChange(cmd, "rds://instance/"+args[0], "", nil)
 
 	return nil
 }
\ No newline at end of file
@@ -3,8 +3,12 @@
 package rds
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/rds"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/rds/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -20,13 +24,6 @@ var (
 	showEngineVersion    bool
 	showModificationInfo bool
 
-	sortName    bool
-	sortCluster bool
-	sortType    bool
-	sortEngine  bool
-	sortStatus  bool
-	sortRole    bool
-
 	reverseSort bool
 )
 
@@ -38,11 +35,11 @@ func init() {
 // Column functions
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Cluster Identifier", Category: "RDS", Visible: true, DefaultSort: true, Merge: true, SortBy: sortCluster, SortDirection: tablewriter.Asc},
-		{Name: "Identifier", Category: "RDS", Visible: true, SortBy: sortName, SortDirection: tablewriter.Asc},
-		{Name: "Status", Category: "RDS", Visible: true, SortBy: sortStatus, SortDirection: tablewriter.Asc},
-		{Name: "Role", Category: "RDS", Visible: true, SortBy: sortRole, SortDirection: tablewriter.Asc},
-		{Name: "Engine", Category: "RDS", Visible: true, SortBy: sortEngine, SortDirection: tablewriter.Asc},
+		{Name: "Cluster Identifier", Category: "RDS", Visible: true, DefaultSort: true, Merge: true, SortDirection: tablewriter.Asc},
+		{Name: "Identifier", Category: "RDS", Visible: true},
+		{Name: "Status", Category: "RDS", Visible: true},
+		{Name: "Role", Category: "RDS", Visible: true},
+		{Name: "Engine", Category: "RDS", Visible: true},
 		{Name: "Engine Version", Category: "RDS", Visible: showEngineVersion},
 		{Name: "Class", Category: "RDS", Visible: true},
 		{Name: "Endpoint", Category: "RDS", Visible: showEndpoint},
@@ -68,15 +65,19 @@ func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&showEndpoint, "endpoint", "e", false, "Show the endpoint of the cluster")
 	cobraCmd.Flags().BoolVarP(&showEngineVersion, "engine-version", "v", false, "Show the engine version of the cluster")
 	cobraCmd.Flags().BoolVarP(&showModificationInfo, "modification-info", "m", false, "Show the modification info of the instance")
-	cmdutil.AddTagFlag(cobraCmd)
+	cmdutil.AddTagFlag(cobraCmd, completion.DBTagKeys())
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 
 	// Add flags - Sorting
-	cobraCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by descending RDS instance identifier.")
-	cobraCmd.Flags().BoolVarP(&sortCluster, "sort-cluster", "c", false, "Sort by descending RDS cluster identifier.")
-	cobraCmd.Flags().BoolVarP(&sortType, "sort-type", "T", false, "Sort by descending RDS instance class.")
-	cobraCmd.Flags().BoolVarP(&sortEngine, "sort-engine", "E", false, "Sort by descending database engine type.")
-	cobraCmd.Flags().BoolVarP(&sortStatus, "sort-status", "s", false, "Sort by descending RDS instance status.")
-	cobraCmd.Flags().BoolVarP(&sortRole, "sort-role", "R", false, "Sort by descending RDS instance role.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "Identifier")
+	cmdutil.AddSortAlias(cobraCmd, "sort-cluster", "c", "Cluster Identifier")
+	cmdutil.AddSortAlias(cobraCmd, "sort-type", "T", "Class")
+	cmdutil.AddSortAlias(cobraCmd, "sort-engine", "E", "Engine")
+	cmdutil.AddSortAlias(cobraCmd, "sort-status", "s", "Status")
+	cmdutil.AddSortAlias(cobraCmd, "sort-role", "R", "Role")
 	cobraCmd.MarkFlagsMutuallyExclusive("sort-name", "sort-cluster", "sort-type", "sort-engine", "sort-status", "sort-role")
 
 	// Add flags - Reverse Sort
@@ -87,35 +88,46 @@ func newLsFlags(cobraCmd *cobra.Command) {
 
 // ListRDSClusters is the function for listing RDS clusters and instances
 func ListRDSClusters(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, rds.NewRDSService)
-	if err != nil {
-		return fmt.Errorf("create new RDS service: %w", err)
-	}
-
-	instances, err := svc.GetInstances(cmd.Context(), &ascTypes.GetInstancesInput{})
-	if err != nil {
-		return fmt.Errorf("list RDS instances: %w", err)
-	}
-
-	clusters, err := svc.GetClusters(cmd.Context(), &ascTypes.GetClustersInput{})
+	var (
+		mu       sync.Mutex
+		clusters []types.DBCluster
+	)
+	results, err := cmdutil.FanOut(cmd, rds.NewRDSService, func(ctx context.Context, svc *rds.RDSService) ([]any, error) {
+		instances, err := svc.GetInstances(ctx, &ascTypes.GetInstancesInput{})
+		if err != nil {
+			return nil, fmt.Errorf("list RDS instances: %w", err)
+		}
+
+		regionClusters, err := svc.GetClusters(ctx, &ascTypes.GetClustersInput{})
+		if err != nil {
+			return nil, fmt.Errorf("list RDS clusters: %w", err)
+		}
+
+		mu.Lock()
+		clusters = append(clusters, regionClusters...)
+		mu.Unlock()
+		return utils.SlicesToAny(instances), nil
+	})
 	if err != nil {
-		return fmt.Errorf("list RDS clusters: %w", err)
+		return err
 	}
 
 	// Set clusters context for role calculation
 	rds.SetClustersContext(clusters)
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Databases",
 		Style:         "rounded-separated",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(instances),
-		GetFieldValue: rds.GetFieldValue,
-		GetTagValue:   rds.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(rds.GetFieldValue),
+		GetTagValue:   results.TagGetter(rds.GetTagValue),
 		ReverseSort:   reverseSort,
 		HideEmpty:     true,
 	})
-	return nil
 }
@@ -5,7 +5,11 @@ package rds
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/rds"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/rds/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -21,6 +25,7 @@ var (
 
 // Init function
 func init() {
+	cmdutil.AllowDryRun(modifyCmd)
 	newModifyFlags(modifyCmd)
 }
 
@@ -44,6 +49,7 @@ var modifyCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ModifyRDSInstance(cmd, args))
 	},
+	ValidArgsFunction: completion.DBInstances(1),
 }
 
 // Command functions
@@ -86,11 +92,62 @@ func ModifyRDSInstance(cmd *cobra.Command, args []string) error {
 		input.PreferredMaintenanceWindow = &preferredMaintenanceWindow
 	}
 
+	if cmdutil.IsDryRun(cmd) {
+		renderModifyPlan(instance[0], input)
+		return nil
+	}
+
 	// Modify the RDS instance
 	err = svc.ModifyInstance(ctx, input)
 	if err != nil {
 		return fmt.Errorf("modify RDS instance: %w", err)
 	}
+	cmdutil.RecordChange(cmd, "rds://instance/"+args[0], "rds/modify", modifyState(instance[0], input))
 
 	return nil
 }
+
+// renderModifyPlan renders the changes that would be made to the RDS instance.
+func renderModifyPlan(instance types.DBInstance, input *ascTypes.ModifyInstanceInput) {
+	var changes []cmdutil.Change
+	if input.DBInstanceClass != nil {
+		changes = append(changes, cmdutil.Change{
+			Resource: *input.DBInstanceIdentifier,
+			Field:    "Instance Class",
+			Before:   aws.ToString(instance.DBInstanceClass),
+			After:    *input.DBInstanceClass,
+		})
+	}
+	if input.PreferredMaintenanceWindow != nil {
+		changes = append(changes, cmdutil.Change{
+			Resource: *input.DBInstanceIdentifier,
+			Field:    "Maintenance Window",
+			Before:   aws.ToString(instance.PreferredMaintenanceWindow),
+			After:    *input.PreferredMaintenanceWindow,
+		})
+	}
+	if len(changes) > 0 {
+		applied := "Next maintenance window"
+		if aws.ToBool(input.ApplyImmediately) {
+			applied = "Immediately"
+		}
+		changes = append(changes, cmdutil.Change{Resource: *input.DBInstanceIdentifier, Field: "Applied", After: applied})
+	}
+	cmdutil.RenderPlan(changes)
+}
+
+// modifyState returns the settings of the RDS instance that were changed, as they were before the
+// change, for recording in the journal. apply_immediately records whether the change was applied
+// immediately, so that undoing it is applied the same way.
+func modifyState(instance types.DBInstance, input *ascTypes.ModifyInstanceInput) map[string]string {
+	state := map[string]string{
+		"apply_immediately": strconv.FormatBool(aws.ToBool(input.ApplyImmediately)),
+	}
+	if input.DBInstanceClass != nil {
+		state["instance_class"] = aws.ToString(instance.DBInstanceClass)
+	}
+	if input.PreferredMaintenanceWindow != nil {
+		state["maintenance_window"] = aws.ToString(instance.PreferredMaintenanceWindow)
+	}
+	return state
+}
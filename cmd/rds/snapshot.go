@@ -3,11 +3,14 @@
 package rds
 
 import (
+	"context"
 	"fmt"
-	"time"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
+	"github.com/harleymckenzie/asc/cmd/wait"
 	"github.com/harleymckenzie/asc/internal/service/rds"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/rds/types"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -20,6 +23,7 @@ var (
 
 // Init function
 func init() {
+	cmdutil.AllowDryRun(snapshotCmd)
 	newSnapshotFlags(snapshotCmd)
 }
 
@@ -28,6 +32,7 @@ func newSnapshotFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().SortFlags = false
 	cobraCmd.Flags().BoolVarP(&snapshotWait, "wait", "w", false, "Wait for the snapshot to complete")
 	cobraCmd.Flags().BoolVarP(&snapshotCluster, "cluster", "c", false, "Snapshot a cluster instead of an instance")
+	wait.AddFlags(cobraCmd)
 }
 
 // Command variable
@@ -43,6 +48,7 @@ var snapshotCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(CreateRDSSnapshot(cmd, args))
 	},
+	ValidArgsFunction: completion.DBInstances(1),
 }
 
 // CreateRDSSnapshot creates a snapshot of an RDS instance or cluster.
@@ -58,6 +64,10 @@ func CreateRDSSnapshot(cmd *cobra.Command, args []string) error {
 		IsCluster:          snapshotCluster,
 	}
 
+	if cmdutil.IsDryRun(cmd) {
+		return renderSnapshotPlan(cmd.Context(), svc, input)
+	}
+
 	err = svc.CreateSnapshot(cmd.Context(), input)
 	if err != nil {
 		return fmt.Errorf("create snapshot: %w", err)
@@ -69,14 +79,44 @@ func CreateRDSSnapshot(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Printf("Snapshot %s created for %s %s\n", args[1], resourceType, args[0])
 
-	if snapshotWait {
-		fmt.Printf("Waiting for snapshot to become available...\n")
-		err = svc.WaitForSnapshot(cmd.Context(), input, 30*time.Minute)
-		if err != nil {
-			return fmt.Errorf("wait for snapshot: %w", err)
-		}
-		fmt.Printf("Snapshot %s is now available\n", args[1])
+	snapshotType := "snapshot"
+	if snapshotCluster {
+		snapshotType = "cluster-snapshot"
+	}
+	cmdutil.RecordChange(cmd, "rds://"+snapshotType+"/"+args[1], "", nil)
+
+	if !snapshotWait {
+		return nil
+	}
+
+	profile, region := cmdutil.GetPersistentFlags(cmd)
+	return wait.ExecuteWait(cmd.Context(), profile, region, &awsutil.ResourceURI{
+		Service:      "rds",
+		ResourceType: snapshotType,
+		Resource:     args[1],
+	})
+}
+
+// renderSnapshotPlan renders the snapshot that would be created, after checking that the source
+// instance or cluster exists.
+func renderSnapshotPlan(ctx context.Context, svc *rds.RDSService, input *ascTypes.CreateSnapshotInput) error {
+	resourceType := "instance"
+	getStatus, getSnapshotStatus := svc.GetInstanceStatus, svc.GetSnapshotStatus
+	if input.IsCluster {
+		resourceType = "cluster"
+		getStatus, getSnapshotStatus = svc.GetClusterStatus, svc.GetClusterSnapshotStatus
+	}
+
+	status, err := getStatus(ctx, input.Identifier)
+	if err != nil {
+		return fmt.Errorf("get %s status: %w", resourceType, err)
 	}
+	// The snapshot normally does not exist yet, in which case the status is empty
+	existing, _ := getSnapshotStatus(ctx, input.SnapshotIdentifier)
 
+	cmdutil.RenderPlan([]cmdutil.Change{
+		{Resource: input.SnapshotIdentifier, Field: "Source", After: fmt.Sprintf("%s %s (%s)", resourceType, input.Identifier, status)},
+		{Resource: input.SnapshotIdentifier, Field: "Status", Before: existing, After: "creating"},
+	})
 	return nil
 }
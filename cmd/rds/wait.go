@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/cmd/wait"
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -18,6 +19,7 @@ func init() {
 func newWaitFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().SortFlags = false
 	cobraCmd.Flags().BoolVarP(&waitCluster, "cluster", "c", false, "Wait for a cluster instead of an instance")
+	wait.AddFlags(cobraCmd)
 }
 
 var waitCmd = &cobra.Command{
@@ -29,6 +31,7 @@ var waitCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(WaitRDSResource(cmd, args))
 	},
+	ValidArgsFunction: completion.DBInstances(1),
 }
 
 func WaitRDSResource(cmd *cobra.Command, args []string) error {
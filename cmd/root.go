@@ -1,29 +1,45 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/harleymckenzie/asc/cmd/asg"
+	"github.com/harleymckenzie/asc/cmd/cache"
 	"github.com/harleymckenzie/asc/cmd/cloudformation"
 	"github.com/harleymckenzie/asc/cmd/ec2"
 	"github.com/harleymckenzie/asc/cmd/ecs"
 	"github.com/harleymckenzie/asc/cmd/efs"
 	"github.com/harleymckenzie/asc/cmd/elasticache"
 	"github.com/harleymckenzie/asc/cmd/elb"
+	"github.com/harleymckenzie/asc/cmd/history"
 	"github.com/harleymckenzie/asc/cmd/organizations"
 	"github.com/harleymckenzie/asc/cmd/profile"
 	"github.com/harleymckenzie/asc/cmd/rds"
+	"github.com/harleymckenzie/asc/cmd/selection"
+	"github.com/harleymckenzie/asc/cmd/show"
 	"github.com/harleymckenzie/asc/cmd/ssm"
+	"github.com/harleymckenzie/asc/cmd/tag"
+	"github.com/harleymckenzie/asc/cmd/undo"
 	"github.com/harleymckenzie/asc/cmd/vpc"
 	"github.com/harleymckenzie/asc/cmd/wait"
+	"github.com/harleymckenzie/asc/cmd/watch"
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/config"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 
 	"github.com/spf13/cobra"
 )
 
 // Global configuration variables
 var (
-	Profile string     // AWS profile to use for authentication
-	Region  string     // AWS region to operate in
-	Version = "0.7.0"  // Current version of the application
+	Profile  string    // AWS profile to use for authentication
+	Region   string    // AWS region to operate in
+	Format   string    // Output format for tables (table, json, yaml, csv, tsv)
+	MaxItems int       // Maximum number of items shown in each list, 0 for no limit
+	Version  = "0.7.0" // Current version of the application
 )
 
 // NewRootCmd creates and configures the root command for the AWS Simple CLI
@@ -31,11 +47,57 @@ func NewRootCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "asc",
 		Short: "AWS Simple CLI (asc) - A simplified interface for AWS operations",
+		// Errors are printed by main, with a hint for common AWS errors
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Arguments and flags have been validated, so errors from here on are not usage errors
+			cmd.SilenceUsage = true
+			if err := applyConfig(cmd); err != nil {
+				return err
+			}
+			profile, _ := cmdutil.GetPersistentFlags(cmd)
+			cmdutil.SetHintProfile(profile)
+			if err := cmdutil.CheckDryRun(cmd); err != nil {
+				return err
+			}
+			if err := cmdutil.ApplyCacheFlags(cmd); err != nil {
+				return err
+			}
+			if MaxItems < 0 {
+				return fmt.Errorf("invalid value for max-items flag: %d. Must be 0 or greater", MaxItems)
+			}
+			tablewriter.MaxItems = MaxItems
+			if err := cmdutil.ApplyEndpointURL(cmd); err != nil {
+				return err
+			}
+			if err := cmdutil.ApplyAssumeRoleFlags(cmd); err != nil {
+				return err
+			}
+			cmdutil.ApplyDebugFlags(cmd)
+			if err := cmdutil.ApplyRetryFlags(cmd); err != nil {
+				return err
+			}
+			return tablewriter.SetFormat(Format)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if tablewriter.Truncated() {
+				fmt.Fprintf(os.Stderr, "Output truncated to %d items (--max-items). More results are available.\n", MaxItems)
+			}
+		},
 	}
 
 	// Add persistent flags for AWS configuration
 	cmd.PersistentFlags().StringVarP(&Profile, "profile", "p", "", "AWS profile to use for authentication")
 	cmd.PersistentFlags().StringVar(&Region, "region", "", "AWS region to operate in")
+	cmd.PersistentFlags().StringVar(&Format, "format", string(tablewriter.FormatTable),
+		fmt.Sprintf("Output format (%s)", strings.Join(tablewriter.ValidFormats, ", ")))
+	cmd.PersistentFlags().IntVar(&MaxItems, "max-items", 0, "Maximum number of items to show in each list (0 for no limit)")
+	cmdutil.AddEndpointFlag(cmd)
+	cmdutil.AddAssumeRoleFlags(cmd)
+	cmdutil.AddDebugFlags(cmd)
+	cmdutil.AddRetryFlags(cmd)
+	cmdutil.AddDryRunFlag(cmd)
+	cmdutil.AddCacheFlags(cmd)
 	cmd.Version = Version
 	awsutil.Version = Version
 
@@ -54,7 +116,18 @@ func NewRootCmd() *cobra.Command {
 	cmd.AddCommand(vpc.NewVPCRootCmd())
 
 	// Add top-level action commands
+	cmd.AddCommand(cache.NewCacheCmd())
+	cmd.AddCommand(history.NewHistoryCmd())
+	cmd.AddCommand(selection.NewSelectCmd())
+	cmd.AddCommand(show.NewShowCmd())
+	cmd.AddCommand(tag.NewTagCmd())
+	cmd.AddCommand(tag.NewUntagCmd())
+	cmd.AddCommand(undo.NewUndoCmd())
 	cmd.AddCommand(wait.NewWaitCmd())
+	cmd.AddCommand(watch.NewWatchCmd())
+
+	// Add --watch to every list and show command
+	cmdutil.AddWatchFlags(cmd)
 
 	// Add command groups for better organization
 	cmd.AddGroup(
@@ -67,8 +140,27 @@ func NewRootCmd() *cobra.Command {
 	return cmd
 }
 
+// applyConfig loads the configuration file and applies it as defaults for the command being run.
+func applyConfig(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	if err := awsutil.ValidateServiceEndpoints(cfg.Endpoints); err != nil {
+		return fmt.Errorf("config: endpoints: %w", err)
+	}
+	awsutil.ServiceEndpoints = cfg.Endpoints
+	if cfg.Style != "" {
+		if err := tablewriter.SetDefaultStyle(cfg.Style); err != nil {
+			return fmt.Errorf("config: %w", err)
+		}
+	}
+	return cfg.Apply(cmd)
+}
+
 // Execute runs the root command and handles any errors
 // This is called by main.main() and only needs to happen once
 func Execute() error {
+	defer awsutil.PrintCallSummary()
 	return NewRootCmd().Execute()
 }
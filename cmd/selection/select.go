@@ -0,0 +1,225 @@
+package selection
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fromLs []string
+	add    bool
+	list   bool
+)
+
+func getListFields() []tablewriter.Field {
+	return []tablewriter.Field{
+		{Name: "URI", Category: "Selection", Visible: true},
+		{Name: "Service", Category: "Selection", Visible: true},
+		{Name: "Type", Category: "Selection", Visible: true},
+		{Name: "Resource", Category: "Selection", Visible: true},
+	}
+}
+
+// NewSelectCmd creates the select command.
+func NewSelectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "select [protocol://resource...]",
+		Short: "Select resources for later commands",
+		Long: `Select resources so that later commands can act on them without repeating their
+identifiers. 'asc show' and 'asc wait' use the selection when no resource is given, and @
+stands for the selected resources among other arguments. Commands that change resources, such
+as 'asc tag' and 'asc ec2 stop', only use the selection when @ is given, and ask for
+confirmation first.
+
+A selection is kept for each profile and region, and for each role given with --role-arn
+and endpoint given with --endpoint-url. Without arguments, the current selection is listed.
+
+Resources are given as protocol-style URIs, or IDs with a known prefix, as for 'asc show'.
+
+With --from-ls, the selection is the resources of a type that match the filters, using the
+same filter expressions as the ls commands. Supported types: ` + strings.Join(slices.Sorted(maps.Keys(sources)), ", ") + `.
+
+The selection is stored in $XDG_STATE_HOME/asc/selection.json, or
+~/.local/state/asc/selection.json. Set ASC_SELECTION to use a different file.`,
+		Example: `  asc select i-0abc i-0def                     # Select two instances
+  asc select --add rds://my-database           # Add a resource to the selection
+  asc select --from-ls State=running ec2       # Select the running instances
+  asc select --from-ls Tag:Env=prod --from-ls State=stopped ec2
+  asc select                                   # List the selection
+  asc ec2 stop                                 # Stop the selected instances
+  asc wait @ --until stopped
+  asc select clear`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(runSelect(cmd, args))
+		},
+	}
+	cmd.Flags().StringArrayVar(&fromLs, "from-ls", nil, "Select the resources of the type given as an argument that match the filter expression, e.g. State=running. Can be repeated")
+	cmd.Flags().BoolVarP(&add, "add", "a", false, "Add to the selection rather than replacing it")
+	cmd.Flags().BoolVarP(&list, "list", "l", false, "Output the selection in list format.")
+	cmd.AddCommand(newClearCmd())
+	return cmd
+}
+
+// newClearCmd creates the select clear command.
+func newClearCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "clear",
+		Short:   "Clear the selection",
+		Example: "  asc select clear",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(cmdutil.SaveSelection(cmd, nil))
+		},
+	}
+}
+
+func runSelect(cmd *cobra.Command, args []string) error {
+	fromLsSet := cmd.Flags().Changed("from-ls")
+	if len(args) == 0 && !fromLsSet {
+		return listSelection(cmd)
+	}
+
+	var uris []*awsutil.ResourceURI
+	var err error
+	if fromLsSet {
+		uris, err = selectFromLs(cmd, args)
+	} else {
+		uris, err = parseURIs(args)
+	}
+	if err != nil {
+		return err
+	}
+
+	if add {
+		current, err := cmdutil.LoadSelection(cmd)
+		if err != nil {
+			return err
+		}
+		uris = merge(current, uris)
+	}
+	if err := cmdutil.SaveSelection(cmd, uris); err != nil {
+		return err
+	}
+	return listSelection(cmd)
+}
+
+// parseURIs parses each argument as a resource.
+func parseURIs(args []string) ([]*awsutil.ResourceURI, error) {
+	uris := make([]*awsutil.ResourceURI, 0, len(args))
+	for _, arg := range args {
+		uri, err := awsutil.ParseResourceURI(arg)
+		if err != nil {
+			return nil, err
+		}
+		uris = append(uris, uri)
+	}
+	return uris, nil
+}
+
+// selectFromLs lists the resources of the type given as the argument and returns those that
+// match the --from-ls filters.
+func selectFromLs(cmd *cobra.Command, args []string) ([]*awsutil.ResourceURI, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("--from-ls requires a single resource type argument, e.g. 'asc select --from-ls State=running ec2'")
+	}
+	source, err := getSource(args[0])
+	if err != nil {
+		return nil, err
+	}
+	filters, err := tablewriter.ParseFilters(fromLs)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, region := cmdutil.GetPersistentFlags(cmd)
+	items, err := source.List(cmd.Context(), profile, region)
+	if err != nil {
+		return nil, err
+	}
+	matched, err := tablewriter.FilterData(filters, source.fields(), items, source.GetFieldValue, source.GetTagValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no %s resources match the filters", args[0])
+	}
+
+	uris := make([]*awsutil.ResourceURI, 0, len(matched))
+	for _, item := range matched {
+		uris = append(uris, source.URI(item))
+	}
+	return uris, nil
+}
+
+// merge appends the resources in added that are not already in current.
+func merge(current, added []*awsutil.ResourceURI) []*awsutil.ResourceURI {
+	seen := make(map[string]bool, len(current))
+	for _, uri := range current {
+		seen[uri.String()] = true
+	}
+	for _, uri := range added {
+		if !seen[uri.String()] {
+			seen[uri.String()] = true
+			current = append(current, uri)
+		}
+	}
+	return current
+}
+
+// listSelection renders the selected resources.
+func listSelection(cmd *cobra.Command) error {
+	uris, err := cmdutil.LoadSelection(cmd)
+	if err != nil {
+		return err
+	}
+	if len(uris) == 0 {
+		fmt.Println("No resources selected.")
+		return nil
+	}
+
+	var data []any
+	for _, uri := range uris {
+		data = append(data, uri)
+	}
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
+		Title:         "Selection",
+		PlainStyle:    list,
+		Fields:        getListFields(),
+		Data:          data,
+		GetFieldValue: getFieldValue,
+		GetTagValue:   getTagValue,
+	})
+}
+
+// getFieldValue returns the value of a field for a selected resource.
+func getFieldValue(fieldName string, instance any) (string, error) {
+	uri, ok := instance.(*awsutil.ResourceURI)
+	if !ok {
+		return "", fmt.Errorf("unsupported type: %T", instance)
+	}
+
+	switch fieldName {
+	case "URI":
+		return uri.String(), nil
+	case "Service":
+		return uri.Service, nil
+	case "Type":
+		return uri.ResourceType, nil
+	case "Resource":
+		return uri.Resource, nil
+	default:
+		return "", fmt.Errorf("unknown field: %s", fieldName)
+	}
+}
+
+// getTagValue is a no-op for selected resources, which don't have tags.
+func getTagValue(tagKey string, instance any) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,192 @@
+package selection
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	efsTypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	elbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/harleymckenzie/asc/internal/service/cloudformation"
+	ascCFTypes "github.com/harleymckenzie/asc/internal/service/cloudformation/types"
+	"github.com/harleymckenzie/asc/internal/service/ec2"
+	ascEC2Types "github.com/harleymckenzie/asc/internal/service/ec2/types"
+	"github.com/harleymckenzie/asc/internal/service/efs"
+	"github.com/harleymckenzie/asc/internal/service/elb"
+	ascELBTypes "github.com/harleymckenzie/asc/internal/service/elb/types"
+	"github.com/harleymckenzie/asc/internal/service/rds"
+	ascRDSTypes "github.com/harleymckenzie/asc/internal/service/rds/types"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/harleymckenzie/asc/internal/shared/utils"
+)
+
+// Source lists the resources of one type for `asc select --from-ls`.
+type Source struct {
+	// Fields are the names of the fields that filters can match, as in the ls command.
+	Fields []string
+	// List returns the resources in the profile and region.
+	List func(ctx context.Context, profile, region string) ([]any, error)
+	// GetFieldValue and GetTagValue return the values that filters are matched against.
+	GetFieldValue tablewriter.AttributeGetter
+	GetTagValue   tablewriter.TagGetter
+	// URI returns the ResourceURI of a listed resource.
+	URI func(item any) *awsutil.ResourceURI
+}
+
+// sources maps the names given to --from-ls, such as "ec2" or "ec2/volume", to their Source.
+var sources = map[string]Source{}
+
+// RegisterSource registers a Source under a name.
+func RegisterSource(name string, source Source) {
+	sources[name] = source
+}
+
+// getSource returns the Source registered under a name.
+func getSource(name string) (Source, error) {
+	source, ok := sources[name]
+	if !ok {
+		return Source{}, fmt.Errorf("unsupported resource type for --from-ls: %s (supported: %s)",
+			name, strings.Join(slices.Sorted(maps.Keys(sources)), ", "))
+	}
+	return source, nil
+}
+
+func init() {
+	RegisterSource("ec2", Source{
+		Fields: []string{"Name", "Instance ID", "State", "AMI ID", "Instance Type", "Public IP", "Private IP",
+			"Subnet ID", "VPC ID", "Availability Zone", "Key Name"},
+		List: func(ctx context.Context, profile, region string) ([]any, error) {
+			svc, err := ec2.NewEC2Service(ctx, profile, region)
+			if err != nil {
+				return nil, fmt.Errorf("create new EC2 service: %w", err)
+			}
+			instances, err := svc.GetInstances(ctx, &ascEC2Types.GetInstancesInput{})
+			if err != nil {
+				return nil, fmt.Errorf("get instances: %w", err)
+			}
+			return utils.SlicesToAny(instances), nil
+		},
+		GetFieldValue: ec2.GetFieldValue,
+		GetTagValue:   ec2.GetTagValue,
+		URI: func(item any) *awsutil.ResourceURI {
+			return &awsutil.ResourceURI{Service: "ec2", ResourceType: "instance", Resource: aws.ToString(item.(ec2Types.Instance).InstanceId)}
+		},
+	})
+
+	RegisterSource("ec2/volume", Source{
+		Fields: []string{"Volume ID", "Type", "Size", "IOPS", "Snapshot ID", "State", "Availability Zone", "Encryption"},
+		List: func(ctx context.Context, profile, region string) ([]any, error) {
+			svc, err := ec2.NewEC2Service(ctx, profile, region)
+			if err != nil {
+				return nil, fmt.Errorf("create new EC2 service: %w", err)
+			}
+			volumes, err := svc.GetVolumes(ctx, &ascEC2Types.GetVolumesInput{})
+			if err != nil {
+				return nil, fmt.Errorf("get volumes: %w", err)
+			}
+			return utils.SlicesToAny(volumes), nil
+		},
+		GetFieldValue: ec2.GetFieldValue,
+		GetTagValue:   ec2.GetTagValue,
+		URI: func(item any) *awsutil.ResourceURI {
+			return &awsutil.ResourceURI{Service: "ec2", ResourceType: "volume", Resource: aws.ToString(item.(ec2Types.Volume).VolumeId)}
+		},
+	})
+
+	RegisterSource("rds", Source{
+		Fields: []string{"Cluster Identifier", "Identifier", "Status", "Role", "Engine", "Engine Version", "Class", "Endpoint"},
+		List: func(ctx context.Context, profile, region string) ([]any, error) {
+			svc, err := rds.NewRDSService(ctx, profile, region)
+			if err != nil {
+				return nil, fmt.Errorf("create new RDS service: %w", err)
+			}
+			instances, err := svc.GetInstances(ctx, &ascRDSTypes.GetInstancesInput{})
+			if err != nil {
+				return nil, fmt.Errorf("get instances: %w", err)
+			}
+			return utils.SlicesToAny(instances), nil
+		},
+		GetFieldValue: rds.GetFieldValue,
+		GetTagValue:   rds.GetTagValue,
+		URI: func(item any) *awsutil.ResourceURI {
+			return &awsutil.ResourceURI{Service: "rds", ResourceType: "instance", Resource: aws.ToString(item.(rdsTypes.DBInstance).DBInstanceIdentifier)}
+		},
+	})
+
+	RegisterSource("cf", Source{
+		Fields: []string{"Stack Name", "Status", "Description", "Last Updated"},
+		List: func(ctx context.Context, profile, region string) ([]any, error) {
+			svc, err := cloudformation.NewCloudFormationService(ctx, profile, region)
+			if err != nil {
+				return nil, fmt.Errorf("create new CloudFormation service: %w", err)
+			}
+			stacks, err := svc.GetStacks(ctx, &ascCFTypes.GetStacksInput{})
+			if err != nil {
+				return nil, fmt.Errorf("get stacks: %w", err)
+			}
+			return utils.SlicesToAny(stacks), nil
+		},
+		GetFieldValue: cloudformation.GetFieldValue,
+		GetTagValue:   cloudformation.GetTagValue,
+		URI: func(item any) *awsutil.ResourceURI {
+			return &awsutil.ResourceURI{Service: "cf", ResourceType: "stack", Resource: aws.ToString(item.(cfTypes.Stack).StackName)}
+		},
+	})
+
+	RegisterSource("elb", Source{
+		Fields: []string{"Name", "DNS Name", "Scheme", "State", "Type", "IP Type", "VPC ID", "ARN"},
+		List: func(ctx context.Context, profile, region string) ([]any, error) {
+			svc, err := elb.NewELBService(ctx, profile, region)
+			if err != nil {
+				return nil, fmt.Errorf("create new ELB service: %w", err)
+			}
+			loadBalancers, err := svc.GetLoadBalancers(ctx, &ascELBTypes.GetLoadBalancersInput{})
+			if err != nil {
+				return nil, fmt.Errorf("get load balancers: %w", err)
+			}
+			return utils.SlicesToAny(loadBalancers), nil
+		},
+		GetFieldValue: elb.GetFieldValue,
+		GetTagValue:   elb.GetTagValue,
+		URI: func(item any) *awsutil.ResourceURI {
+			return &awsutil.ResourceURI{Service: "elb", ResourceType: "load-balancer", Resource: aws.ToString(item.(elbTypes.LoadBalancer).LoadBalancerName)}
+		},
+	})
+
+	RegisterSource("efs", Source{
+		Fields: []string{"Name", "File System ID", "State", "Performance Mode", "Throughput Mode", "Encrypted"},
+		List: func(ctx context.Context, profile, region string) ([]any, error) {
+			svc, err := efs.NewEFSService(ctx, profile, region)
+			if err != nil {
+				return nil, fmt.Errorf("create new EFS service: %w", err)
+			}
+			fileSystems, err := svc.GetFileSystems(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("get file systems: %w", err)
+			}
+			return utils.SlicesToAny(fileSystems), nil
+		},
+		GetFieldValue: efs.GetFieldValue,
+		GetTagValue:   efs.GetTagValue,
+		URI: func(item any) *awsutil.ResourceURI {
+			return &awsutil.ResourceURI{Service: "efs", ResourceType: "file-system", Resource: aws.ToString(item.(efsTypes.FileSystemDescription).FileSystemId)}
+		},
+	})
+}
+
+// fields returns the fields of a Source in the form tablewriter.FilterData expects.
+func (s Source) fields() []tablewriter.Field {
+	fields := make([]tablewriter.Field, 0, len(s.Fields))
+	for _, name := range s.Fields {
+		fields = append(fields, tablewriter.Field{Name: name})
+	}
+	return fields
+}
@@ -0,0 +1,83 @@
+package show
+
+import (
+	"github.com/harleymckenzie/asc/cmd/cloudformation"
+	"github.com/harleymckenzie/asc/cmd/ec2"
+	"github.com/harleymckenzie/asc/cmd/ec2/ami"
+	"github.com/harleymckenzie/asc/cmd/ec2/security_group"
+	"github.com/harleymckenzie/asc/cmd/ec2/snapshot"
+	"github.com/harleymckenzie/asc/cmd/ec2/volume"
+	ecsCluster "github.com/harleymckenzie/asc/cmd/ecs/cluster"
+	ecsService "github.com/harleymckenzie/asc/cmd/ecs/service"
+	ecsTask "github.com/harleymckenzie/asc/cmd/ecs/task"
+	"github.com/harleymckenzie/asc/cmd/ecs/taskdefinition"
+	"github.com/harleymckenzie/asc/cmd/efs"
+	"github.com/harleymckenzie/asc/cmd/rds"
+	rdsCluster "github.com/harleymckenzie/asc/cmd/rds/cluster"
+	"github.com/harleymckenzie/asc/cmd/ssm"
+	"github.com/harleymckenzie/asc/cmd/vpc"
+	"github.com/harleymckenzie/asc/cmd/vpc/igw"
+	"github.com/harleymckenzie/asc/cmd/vpc/nacl"
+	natGateway "github.com/harleymckenzie/asc/cmd/vpc/nat-gateway"
+	prefixList "github.com/harleymckenzie/asc/cmd/vpc/prefix-list"
+	routeTable "github.com/harleymckenzie/asc/cmd/vpc/route-table"
+	"github.com/harleymckenzie/asc/cmd/vpc/subnet"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	// EC2
+	RegisterHandler("ec2/instance", func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return ec2.ShowEC2Instance(cmd, []string{uri.Resource})
+	})
+	RegisterHandler("ec2/volume", resourceHandler(volume.ShowEC2Volume))
+	RegisterHandler("ec2/snapshot", resourceHandler(snapshot.ShowEC2Snapshot))
+	RegisterHandler("ec2/image", resourceHandler(ami.ShowEC2AMI))
+	RegisterHandler("ec2/security-group", resourceHandler(security_group.ShowSecurityGroup))
+
+	// RDS
+	RegisterHandler("rds/instance", func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return rds.ShowRDSInstance(cmd, []string{uri.Resource})
+	})
+	RegisterHandler("rds/cluster", func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return rdsCluster.ShowRDSCluster(cmd, []string{uri.Resource})
+	})
+
+	// CloudFormation
+	RegisterHandler("cf/stack", func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return cloudformation.ShowCloudFormationStack(cmd, []string{uri.Resource})
+	})
+
+	// ECS
+	RegisterHandler("ecs/cluster", resourceHandler(ecsCluster.ShowCluster))
+	RegisterHandler("ecs/service", func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return ecsService.ShowServiceInCluster(cmd, uri.Params["cluster"], uri.Resource)
+	})
+	RegisterHandler("ecs/task", func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return ecsTask.ShowTaskInCluster(cmd, uri.Params["cluster"], uri.Resource)
+	})
+	RegisterHandler("ecs/task-definition", resourceHandler(taskdefinition.ShowTaskDefinition))
+
+	// EFS
+	RegisterHandler("efs/file-system", resourceHandler(efs.ShowFileSystem))
+
+	// SSM
+	RegisterHandler("ssm/parameter", resourceHandler(ssm.ShowSSMParameter))
+
+	// VPC
+	RegisterHandler("vpc/vpc", resourceHandler(vpc.ShowVPC))
+	RegisterHandler("vpc/subnet", resourceHandler(subnet.ShowSubnet))
+	RegisterHandler("vpc/internet-gateway", resourceHandler(igw.ShowVPCIGW))
+	RegisterHandler("vpc/nat-gateway", resourceHandler(natGateway.ShowNatGateway))
+	RegisterHandler("vpc/route-table", resourceHandler(routeTable.ShowRouteTable))
+	RegisterHandler("vpc/network-acl", resourceHandler(nacl.ShowNACL))
+	RegisterHandler("vpc/prefix-list", resourceHandler(prefixList.ShowPrefixList))
+}
+
+// resourceHandler adapts a service show function that takes the resource identifier.
+func resourceHandler(fn func(cmd *cobra.Command, id string) error) ShowHandler {
+	return func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return fn(cmd, uri.Resource)
+	}
+}
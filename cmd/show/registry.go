@@ -0,0 +1,30 @@
+package show
+
+import (
+	"fmt"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+)
+
+// ShowHandler displays the details of the resource identified by the URI. Handlers use the
+// command for the profile, region and output layout flags.
+type ShowHandler func(cmd *cobra.Command, uri *awsutil.ResourceURI) error
+
+var handlers = map[string]ShowHandler{}
+
+// RegisterHandler registers a show handler for a service/resourceType combination.
+// key format: "service/resourceType" (e.g. "ec2/instance", "rds/cluster").
+func RegisterHandler(key string, handler ShowHandler) {
+	handlers[key] = handler
+}
+
+// getHandler returns the registered handler for a ResourceURI, or an error if none exists.
+func getHandler(uri *awsutil.ResourceURI) (ShowHandler, error) {
+	key := fmt.Sprintf("%s/%s", uri.Service, uri.ResourceType)
+	handler, ok := handlers[key]
+	if !ok {
+		return nil, fmt.Errorf("show is not supported for %s %s", uri.Service, uri.ResourceType)
+	}
+	return handler, nil
+}
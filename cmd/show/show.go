@@ -0,0 +1,80 @@
+package show
+
+import (
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewShowCmd creates the top-level show command.
+func NewShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show [protocol://resource]",
+		Short:   "Show detailed information about any AWS resource",
+		Aliases: []string{"describe"},
+		Long: `Show detailed information about an AWS resource, using the same view as the
+service's own show command.
+
+Supports protocol-style URIs to identify the service and resource type:
+  ec2://i-xxx                            EC2 instance
+  ec2://volume/vol-xxx                   EC2 volume
+  ec2://snapshot/snap-xxx                EC2 snapshot
+  ec2://image/ami-xxx                    EC2 AMI
+  ec2://security-group/sg-xxx            EC2 security group
+  rds://my-database                      RDS instance
+  rds://cluster/my-cluster               RDS cluster
+  cf://my-stack                          CloudFormation stack
+  ecs://cluster/my-cluster               ECS cluster
+  ecs://service/my-cluster/my-service    ECS service
+  ecs://task/my-cluster/task-id          ECS task
+  ecs://task-definition/my-family:1      ECS task definition
+  efs://fs-xxx                           EFS file system
+  ssm:///my/parameter                    SSM parameter
+  vpc://vpc/vpc-xxx                      VPC
+  vpc://subnet/subnet-xxx                VPC subnet
+  vpc://internet-gateway/igw-xxx         VPC internet gateway
+  vpc://nat-gateway/nat-xxx              VPC NAT gateway
+  vpc://route-table/rtb-xxx              VPC route table
+  vpc://network-acl/acl-xxx              VPC network ACL
+  vpc://prefix-list/pl-xxx               VPC prefix list
+
+Resources with known ID prefixes can omit the protocol:
+  i-xxx, vol-xxx, snap-xxx, ami-xxx, sg-xxx, fs-xxx, vpc-xxx, subnet-xxx,
+  igw-xxx, nat-xxx, rtb-xxx, acl-xxx, pl-xxx
+
+Without a resource, or with @, each resource selected with 'asc select' is shown.`,
+		Example: `  asc show vol-1234567890abcdef0
+  asc show rds://cluster/my-cluster
+  asc show ecs://service/my-cluster/my-service
+  asc show ssm:///myapp/prod/db-host -o grid
+  asc show @`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(runShow(cmd, args))
+		},
+	}
+	cmdutil.AddShowFlags(cmd, "vertical")
+	return cmd
+}
+
+func runShow(cmd *cobra.Command, args []string) error {
+	args, err := cmdutil.ResolveSelection(cmd, args)
+	if err != nil {
+		return err
+	}
+	for _, arg := range args {
+		uri, err := awsutil.ParseResourceURI(arg)
+		if err != nil {
+			return err
+		}
+
+		handler, err := getHandler(uri)
+		if err != nil {
+			return err
+		}
+		if err := handler(cmd, uri); err != nil {
+			return err
+		}
+	}
+	return nil
+}
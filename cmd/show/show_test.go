@@ -0,0 +1,123 @@
+package show
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shown is a call to a stubbed show handler.
+type shown struct {
+	key      string
+	resource string
+	params   map[string]string
+}
+
+// stubHandlers replaces each registered handler with a stub that records the resources shown.
+func stubHandlers(t *testing.T) *[]shown {
+	t.Helper()
+	registered := handlers
+	t.Cleanup(func() { handlers = registered })
+
+	var calls []shown
+	handlers = map[string]ShowHandler{}
+	for key := range registered {
+		handlers[key] = func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+			calls = append(calls, shown{key: key, resource: uri.Resource, params: uri.Params})
+			return nil
+		}
+	}
+	return &calls
+}
+
+// Unit test for runShow dispatching to the registered handlers
+func TestRunShow(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    shown
+		wantErr string
+	}{
+		{name: "instance by prefix", input: "i-0abc", want: shown{key: "ec2/instance", resource: "i-0abc"}},
+		{name: "volume by prefix", input: "vol-0abc", want: shown{key: "ec2/volume", resource: "vol-0abc"}},
+		{name: "security group by prefix", input: "sg-0abc", want: shown{key: "ec2/security-group", resource: "sg-0abc"}},
+		{name: "file system by prefix", input: "fs-0abc", want: shown{key: "efs/file-system", resource: "fs-0abc"}},
+		{name: "nat gateway by prefix", input: "nat-0abc", want: shown{key: "vpc/nat-gateway", resource: "nat-0abc"}},
+		{name: "default type", input: "rds://my-database", want: shown{key: "rds/instance", resource: "my-database"}},
+		{name: "resource type", input: "rds://cluster/my-cluster", want: shown{key: "rds/cluster", resource: "my-cluster"}},
+		{name: "path parameter", input: "ecs://service/prod/web", want: shown{key: "ecs/service", resource: "web", params: map[string]string{"cluster": "prod"}}},
+		{name: "parameter path", input: "ssm:///app/db", want: shown{key: "ssm/parameter", resource: "/app/db"}},
+		{name: "unknown protocol", input: "s3://my-bucket", wantErr: "unknown service: s3"},
+		{name: "unknown prefix", input: "my-database", wantErr: `cannot determine service for "my-database"`},
+		{name: "missing path parameter", input: "ecs://service/web", wantErr: "missing cluster in URI"},
+		{name: "no handler for the service", input: "asg://web", wantErr: "show is not supported for asg auto-scaling-group"},
+		{name: "no handler for the resource type", input: "rds://snapshot/snap-1", wantErr: "show is not supported for rds snapshot"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := stubHandlers(t)
+			err := runShow(NewShowCmd(), []string{tt.input})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Empty(t, *calls)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, *calls, 1)
+			assert.Equal(t, tt.want.key, (*calls)[0].key)
+			assert.Equal(t, tt.want.resource, (*calls)[0].resource)
+			if tt.want.params != nil {
+				assert.Equal(t, tt.want.params, (*calls)[0].params)
+			}
+		})
+	}
+}
+
+// Unit test for runShow stopping at the first resource that fails
+func TestRunShowStopsOnError(t *testing.T) {
+	calls := stubHandlers(t)
+	handlers["ec2/volume"] = func(cmd *cobra.Command, uri *awsutil.ResourceURI) error {
+		return errors.New("volume not found")
+	}
+
+	err := runShow(NewShowCmd(), []string{"i-0abc", "vol-0abc", "sg-0abc"})
+	assert.EqualError(t, err, "volume not found")
+	require.Len(t, *calls, 1)
+	assert.Equal(t, "ec2/instance", (*calls)[0].key)
+}
+
+// Unit test for the handler registry, checking that every resource in the help has a handler
+func TestRegisteredHandlers(t *testing.T) {
+	var inputs []string
+	for _, line := range strings.Split(NewShowCmd().Long, "\n") {
+		if !strings.HasPrefix(line, "  ") {
+			continue
+		}
+		if fields := strings.Fields(line); strings.Contains(fields[0], "://") {
+			inputs = append(inputs, fields[0])
+			continue
+		}
+		// Resources detected by their ID prefix, e.g. "i-xxx, vol-xxx"
+		for _, id := range strings.Split(line, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				inputs = append(inputs, id)
+			}
+		}
+	}
+	require.NotEmpty(t, inputs)
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			uri, err := awsutil.ParseResourceURI(input)
+			require.NoError(t, err)
+			_, err = getHandler(uri)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -26,6 +27,7 @@ var catCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(CatSSMParameter(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 func newCatFlags(cmd *cobra.Command) {
@@ -6,7 +6,7 @@ import (
 	"os"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -38,6 +38,7 @@ var cpCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(CopySSMParameter(cmd, args[0], args[1]))
 	},
+	ValidArgsFunction: completion.ParameterNames(2),
 }
 
 // newCpFlags configures the flags for the cp command.
@@ -67,24 +68,15 @@ func CopySSMParameter(cmd *cobra.Command, source, dest string) error {
 
 	if recursive {
 		if cpDryRun {
-			params, err := svc.GetParametersByPath(ctx, &ascTypes.GetParametersByPathInput{
-				Path:      source,
-				Recursive: true,
-				Decrypt:   false,
-			})
+			changes, err := recursiveCopyPlan(ctx, svc, source, dest, false)
 			if err != nil {
 				return fmt.Errorf("get parameters by path: %w", err)
 			}
-			if len(params) == 0 {
+			if len(changes) == 0 {
 				fmt.Printf("No parameters found under path: %s\n", source)
 				return nil
 			}
-			fmt.Printf("Dry run: %d parameter(s) would be copied:\n", len(params))
-			for _, p := range params {
-				srcName := aws.ToString(p.Name)
-				destName := strings.TrimSuffix(dest, "/") + strings.TrimPrefix(srcName, strings.TrimSuffix(source, "/"))
-				fmt.Printf("  %s -> %s\n", srcName, destName)
-			}
+			cmdutil.RenderPlan(changes)
 			return nil
 		}
 		// Recursive copy
@@ -96,6 +88,7 @@ func CopySSMParameter(cmd *cobra.Command, source, dest string) error {
 			fmt.Printf("No parameters found under path: %s\n", source)
 		} else {
 			fmt.Printf("Copied %d parameter(s) from %s to %s\n", count, source, dest)
+			cmdutil.RecordChange(cmd, parameterURI(dest), "", nil)
 		}
 	} else {
 		// Handle directory-style destination (trailing slash)
@@ -105,7 +98,7 @@ func CopySSMParameter(cmd *cobra.Command, source, dest string) error {
 		}
 
 		if cpDryRun {
-			fmt.Printf("Would copy %s to %s\n", source, dest)
+			cmdutil.RenderPlan(copyPlan(ctx, svc, []string{source}, []string{dest}, false))
 			return nil
 		}
 
@@ -145,6 +138,7 @@ func CopySSMParameter(cmd *cobra.Command, source, dest string) error {
 			return fmt.Errorf("copy parameter: %w", err)
 		}
 		fmt.Printf("Copied %s to %s\n", source, dest)
+		recordParameterChange(cmd, dest, existingParam)
 	}
 
 	return nil
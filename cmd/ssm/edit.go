@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -15,6 +17,7 @@ import (
 
 func init() {
 	newEditFlags(editCmd)
+	cmdutil.BypassCache(editCmd)
 }
 
 var editCmd = &cobra.Command{
@@ -27,10 +30,11 @@ var editCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(EditSSMParameter(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 func newEditFlags(cmd *cobra.Command) {
-	// No flags needed for now, but keeping the pattern consistent
+	cmdutil.AllowDryRun(cmd)
 }
 
 func EditSSMParameter(cmd *cobra.Command, args []string) error {
@@ -98,6 +102,15 @@ func EditSSMParameter(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if cmdutil.IsDryRun(cmd) {
+		after := newValue
+		if param.Type == types.ParameterTypeSecureString {
+			after = maskedValue
+		}
+		cmdutil.RenderPlan([]cmdutil.Change{{Resource: paramName, Field: "Value", Before: planValue(param), After: after}})
+		return nil
+	}
+
 	// Update parameter
 	err = svc.PutParameter(ctx, &ascTypes.PutParameterInput{
 		Name:      paramName,
@@ -110,6 +123,7 @@ func EditSSMParameter(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("Updated: %s\n", paramName)
+	recordParameterChange(cmd, paramName, param)
 	return nil
 }
 
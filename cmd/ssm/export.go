@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -34,6 +35,7 @@ var exportCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ExportSSMParameters(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 func newExportFlags(cmd *cobra.Command) {
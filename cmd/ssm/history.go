@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -45,6 +46,7 @@ var historyCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ShowParameterHistory(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 func newHistoryFlags(cmd *cobra.Command) {
@@ -84,7 +86,8 @@ func ShowParameterHistory(cmd *cobra.Command, args []string) error {
 	}
 
 	table := tablewriter.NewAscWriter(tablewriter.AscTableRenderOptions{
-		Title: fmt.Sprintf("History: %s", displayName),
+		Title:   fmt.Sprintf("History: %s", displayName),
+		MaxRows: tablewriter.MaxItems,
 	})
 	if historyList {
 		table.SetRenderStyle("plain")
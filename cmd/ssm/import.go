@@ -125,6 +125,7 @@ func ImportSSMParameters(cmd *cobra.Command, args []string) error {
 					return fmt.Errorf("update %s: %w", paramName, err)
 				}
 				fmt.Printf("Updated: %s\n", paramName)
+				recordParameterChange(cmd, paramName, existing)
 			}
 		} else {
 			if importDryRun {
@@ -139,6 +140,7 @@ func ImportSSMParameters(cmd *cobra.Command, args []string) error {
 					return fmt.Errorf("create %s: %w", paramName, err)
 				}
 				fmt.Printf("Created: %s\n", paramName)
+				recordParameterChange(cmd, paramName, nil)
 			}
 		}
 		imported++
@@ -0,0 +1,19 @@
+package ssm
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/harleymckenzie/asc/internal/service/ssm"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// parameterURI returns the ResourceURI of a parameter, used as the target of journal entries.
+func parameterURI(name string) string {
+	return "ssm://parameter/" + name
+}
+
+// recordParameterChange records a new value of a parameter in the journal. The change can be
+// undone by restoring the version before it, or deleting the parameter if before is nil.
+func recordParameterChange(cmd *cobra.Command, name string, before *types.Parameter) {
+	cmdutil.RecordChange(cmd, parameterURI(name), "ssm/parameter", ssm.ParameterState(before))
+}
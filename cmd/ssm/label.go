@@ -13,6 +13,7 @@ import (
 
 func init() {
 	newLabelFlags(labelCmd)
+	cmdutil.BypassCache(labelCmd)
 }
 
 var labelCmd = &cobra.Command{
@@ -38,7 +39,7 @@ periods (.), hyphens (-), and underscores (_).`,
 }
 
 func newLabelFlags(cmd *cobra.Command) {
-	// No flags needed
+	cmdutil.AllowDryRun(cmd)
 }
 
 func LabelParameterVersion(cmd *cobra.Command, args []string) error {
@@ -58,6 +59,18 @@ func LabelParameterVersion(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmdutil.IsDryRun(cmd) {
+		current, latest, err := labelVersions(ctx, svc, paramName)
+		if err != nil {
+			return fmt.Errorf("get parameter history: %w", err)
+		}
+		if version == 0 {
+			version = latest
+		}
+		cmdutil.RenderPlan(labelPlan(paramName, labels, current, version))
+		return nil
+	}
+
 	invalidLabels, err := svc.LabelParameterVersion(ctx, &ascTypes.LabelParameterVersionInput{
 		Name:    paramName,
 		Version: version,
@@ -79,6 +92,7 @@ func LabelParameterVersion(cmd *cobra.Command, args []string) error {
 		} else {
 			fmt.Printf("Added %d label(s) to %s (latest version)\n", validCount, paramName)
 		}
+		cmdutil.RecordChange(cmd, parameterURI(paramName), "", nil)
 	}
 
 	return nil
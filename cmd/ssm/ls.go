@@ -6,6 +6,7 @@ import (
 	"path"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -18,8 +19,6 @@ import (
 var (
 	list        bool
 	showValues  bool
-	sortByName  bool
-	sortByDate  bool
 	reverseSort bool
 )
 
@@ -31,10 +30,10 @@ func init() {
 // getListFields returns a list of Field objects for displaying SSM parameters.
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Name", Category: "Parameter Details", Visible: true, DefaultSort: true, SortBy: sortByName, SortDirection: tablewriter.Asc},
+		{Name: "Name", Category: "Parameter Details", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
 		{Name: "Type", Category: "Parameter Details", Visible: true},
 		{Name: "Value", Category: "Parameter Details", Visible: showValues},
-		{Name: "Last Modified Date", Category: "Parameter Details", Visible: true, SortBy: sortByDate, SortDirection: tablewriter.Desc},
+		{Name: "Last Modified Date", Category: "Parameter Details", Visible: true},
 		{Name: "Last Modified User", Category: "Parameter Details", Visible: false},
 		{Name: "Version", Category: "Parameter Details", Visible: true},
 		{Name: "Tier", Category: "Parameter Details", Visible: false},
@@ -53,6 +52,7 @@ var lsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(ListSSMParameters(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 // newLsFlags configures the flags for the ls command.
@@ -62,9 +62,11 @@ func newLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&showValues, "values", "v", false, "Show parameter values in the output.")
 
 	// Sorting flags
-	cobraCmd.Flags().BoolVarP(&sortByName, "sort-name", "n", false, "Sort by parameter name.")
-	cobraCmd.Flags().BoolVarP(&sortByDate, "sort-date", "d", false, "Sort by last modified date (most recent first).")
+	cmdutil.AddSortAlias(cobraCmd, "sort-name", "n", "Name")
+	cmdutil.AddSortAlias(cobraCmd, "sort-date", "d", "Last Modified Date:desc")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
 	cobraCmd.MarkFlagsMutuallyExclusive("sort-name", "sort-date")
 }
 
@@ -190,15 +192,32 @@ func ListSSMParameters(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	fields := getListFields()
+	filters, err := tablewriter.ParseFilters(cmdutil.Filters)
+	if err != nil {
+		return err
+	}
+	resources, err = tablewriter.FilterData(filters, fields, resources, ssm.GetFieldValue, ssm.GetTagValue)
+	if err != nil {
+		return err
+	}
+
+	fields, _ = tablewriter.ShowSortColumns(fields, nil, cmdutil.Sort)
+	headerRow := tablewriter.BuildHeaderRow(fields)
+	sortBy, err := tablewriter.ParseSort(cmdutil.Sort, headerRow)
+	if err != nil {
+		return err
+	}
+
 	table := tablewriter.NewAscWriter(tablewriter.AscTableRenderOptions{
-		Title: "Parameters",
+		Title:   "Parameters",
+		SortBy:  sortBy,
+		MaxRows: tablewriter.MaxItems,
 	})
 	if list {
 		table.SetRenderStyle("plain")
 	}
 
-	fields := getListFields()
-	headerRow := tablewriter.BuildHeaderRow(fields)
 	table.AppendHeader(headerRow)
 	table.AppendRows(tablewriter.BuildRows(resources, fields, ssm.GetFieldValue, ssm.GetTagValue))
 	table.SetFieldConfigs(fields, reverseSort)
@@ -6,7 +6,7 @@ import (
 	"os"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -35,6 +35,7 @@ var mvCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(MoveSSMParameter(cmd, args[0], args[1]))
 	},
+	ValidArgsFunction: completion.ParameterNames(2),
 }
 
 // newMvFlags configures the flags for the mv command.
@@ -60,24 +61,15 @@ func MoveSSMParameter(cmd *cobra.Command, source, dest string) error {
 
 	if mvRecursive {
 		if mvDryRun {
-			params, err := svc.GetParametersByPath(ctx, &ascTypes.GetParametersByPathInput{
-				Path:      source,
-				Recursive: true,
-				Decrypt:   false,
-			})
+			changes, err := recursiveCopyPlan(ctx, svc, source, dest, true)
 			if err != nil {
 				return fmt.Errorf("get parameters by path: %w", err)
 			}
-			if len(params) == 0 {
+			if len(changes) == 0 {
 				fmt.Printf("No parameters found under path: %s\n", source)
 				return nil
 			}
-			fmt.Printf("Dry run: %d parameter(s) would be moved:\n", len(params))
-			for _, p := range params {
-				srcName := aws.ToString(p.Name)
-				destName := strings.TrimSuffix(dest, "/") + strings.TrimPrefix(srcName, strings.TrimSuffix(source, "/"))
-				fmt.Printf("  %s -> %s\n", srcName, destName)
-			}
+			cmdutil.RenderPlan(changes)
 			return nil
 		}
 		// Recursive move
@@ -89,10 +81,11 @@ func MoveSSMParameter(cmd *cobra.Command, source, dest string) error {
 			fmt.Printf("No parameters found under path: %s\n", source)
 		} else {
 			fmt.Printf("Moved %d parameter(s) from %s to %s\n", count, source, dest)
+			cmdutil.RecordChange(cmd, parameterURI(dest), "", nil)
 		}
 	} else {
 		if mvDryRun {
-			fmt.Printf("Would move %s to %s\n", source, dest)
+			cmdutil.RenderPlan(copyPlan(ctx, svc, []string{source}, []string{dest}, true))
 			return nil
 		}
 		// Single parameter move
@@ -104,6 +97,7 @@ func MoveSSMParameter(cmd *cobra.Command, source, dest string) error {
 			return fmt.Errorf("move parameter: %w", err)
 		}
 		fmt.Printf("Moved %s to %s\n", source, dest)
+		cmdutil.RecordChange(cmd, parameterURI(dest), "", nil)
 	}
 
 	return nil
@@ -0,0 +1,110 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/harleymckenzie/asc/internal/service/ssm"
+	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+)
+
+// maskedValue is shown in place of SecureString values in dry-run plans.
+const maskedValue = "********"
+
+// currentParameter returns a parameter, or nil if it does not exist.
+func currentParameter(ctx context.Context, svc *ssm.SSMService, name string) *types.Parameter {
+	param, err := svc.GetParameter(ctx, &ascTypes.GetParameterInput{Name: name})
+	if err != nil {
+		return nil
+	}
+	return param
+}
+
+// planValue returns the value of a parameter as shown in a dry-run plan. SecureString values
+// are masked.
+func planValue(param *types.Parameter) string {
+	if param == nil {
+		return ""
+	}
+	if param.Type == types.ParameterTypeSecureString {
+		return maskedValue
+	}
+	return aws.ToString(param.Value)
+}
+
+// copyPlan returns the changes made by copying each source parameter to its destination. If
+// move is true, the source parameters are shown as deleted.
+func copyPlan(ctx context.Context, svc *ssm.SSMService, sources, dests []string, move bool) []cmdutil.Change {
+	var changes []cmdutil.Change
+	for i, source := range sources {
+		src := currentParameter(ctx, svc, source)
+		changes = append(changes, cmdutil.Change{
+			Resource: dests[i],
+			Field:    "Value",
+			Before:   planValue(currentParameter(ctx, svc, dests[i])),
+			After:    planValue(src),
+		})
+		if move {
+			changes = append(changes, cmdutil.Change{Resource: source, Field: "Value", Before: planValue(src)})
+		}
+	}
+	return changes
+}
+
+// recursiveCopyPlan returns the changes made by copying every parameter under source to dest.
+func recursiveCopyPlan(ctx context.Context, svc *ssm.SSMService, source, dest string, move bool) ([]cmdutil.Change, error) {
+	params, err := svc.GetParametersByPath(ctx, &ascTypes.GetParametersByPathInput{
+		Path:      source,
+		Recursive: true,
+		Decrypt:   false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var sources, dests []string
+	for _, p := range params {
+		srcName := aws.ToString(p.Name)
+		sources = append(sources, srcName)
+		dests = append(dests, strings.TrimSuffix(dest, "/")+strings.TrimPrefix(srcName, strings.TrimSuffix(source, "/")))
+	}
+	return copyPlan(ctx, svc, sources, dests, move), nil
+}
+
+// labelVersions returns the version each label of a parameter is attached to, and the latest
+// version of the parameter.
+func labelVersions(ctx context.Context, svc *ssm.SSMService, name string) (map[string]int64, int64, error) {
+	history, err := svc.GetParameterHistory(ctx, &ascTypes.GetParameterHistoryInput{Name: name})
+	if err != nil {
+		return nil, 0, err
+	}
+	labels := map[string]int64{}
+	var latest int64
+	for _, h := range history {
+		latest = max(latest, h.Version)
+		for _, label := range h.Labels {
+			labels[label] = h.Version
+		}
+	}
+	return labels, latest, nil
+}
+
+// labelPlan returns the changes made by moving each label to the given version. A version of 0
+// removes the labels.
+func labelPlan(name string, labels []string, current map[string]int64, version int64) []cmdutil.Change {
+	var changes []cmdutil.Change
+	for _, label := range labels {
+		change := cmdutil.Change{Resource: name, Field: "Label " + label}
+		if v, ok := current[label]; ok {
+			change.Before = fmt.Sprintf("version %d", v)
+		}
+		if version > 0 {
+			change.After = fmt.Sprintf("version %d", version)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
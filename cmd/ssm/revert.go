@@ -3,6 +3,7 @@ package ssm
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -29,10 +30,11 @@ You can specify a version number or a label.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(RevertParameter(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 func newRevertFlags(cmd *cobra.Command) {
-	// No flags needed
+	cmdutil.AllowDryRun(cmd)
 }
 
 func RevertParameter(cmd *cobra.Command, args []string) error {
@@ -49,6 +51,22 @@ func RevertParameter(cmd *cobra.Command, args []string) error {
 	// Construct the source with version/label
 	source := fmt.Sprintf("%s:%s", paramName, versionOrLabel)
 
+	current := currentParameter(ctx, svc, paramName)
+
+	if cmdutil.IsDryRun(cmd) {
+		target := currentParameter(ctx, svc, source)
+		if target == nil {
+			return fmt.Errorf("version %s of %s not found", versionOrLabel, paramName)
+		}
+		cmdutil.RenderPlan([]cmdutil.Change{{
+			Resource: paramName,
+			Field:    "Value",
+			Before:   planValue(current),
+			After:    planValue(target),
+		}})
+		return nil
+	}
+
 	// Copy the old version to itself (creates new current version)
 	err = svc.CopyParameter(ctx, &ascTypes.CopyParameterInput{
 		Source:    source,
@@ -58,6 +76,7 @@ func RevertParameter(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("revert parameter: %w", err)
 	}
+	recordParameterChange(cmd, paramName, current)
 
 	fmt.Printf("Reverted %s to version %s\n", paramName, versionOrLabel)
 	return nil
@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -39,6 +41,7 @@ var rmCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(DeleteSSMParameter(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(0),
 }
 
 // newRmFlags configures the flags for the rm command.
@@ -93,12 +96,17 @@ func DeleteSSMParameter(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Dry run: list what would be deleted and exit
+	// Dry run: show what would be deleted and exit
 	if rmDryRun {
-		fmt.Printf("Dry run: %d parameter(s) would be deleted:\n", len(names))
+		var changes []cmdutil.Change
 		for _, name := range names {
-			fmt.Printf("  - %s\n", name)
+			changes = append(changes, cmdutil.Change{
+				Resource: name,
+				Field:    "Value",
+				Before:   planValue(currentParameter(ctx, svc, name)),
+			})
 		}
+		cmdutil.RenderPlan(changes)
 		return nil
 	}
 
@@ -133,6 +141,7 @@ func DeleteSSMParameter(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("delete parameter: %w", err)
 		}
 		fmt.Printf("Deleted: %s\n", names[0])
+		cmdutil.RecordChange(cmd, parameterURI(names[0]), "", nil)
 	} else {
 		// Batch delete
 		failed, err := svc.DeleteParameters(ctx, &ascTypes.DeleteParametersInput{
@@ -144,6 +153,11 @@ func DeleteSSMParameter(cmd *cobra.Command, args []string) error {
 
 		deleted := len(names) - len(failed)
 		fmt.Printf("Deleted %d parameter(s).\n", deleted)
+		for _, name := range names {
+			if !slices.Contains(failed, name) {
+				cmdutil.RecordChange(cmd, parameterURI(name), "", nil)
+			}
+		}
 
 		if len(failed) > 0 {
 			fmt.Println("Failed to delete:")
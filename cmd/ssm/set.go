@@ -7,6 +7,8 @@ import (
 	"os"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -46,6 +48,7 @@ For sensitive values, use --stdin to read from stdin (avoids shell history).`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(SetSSMParameter(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 func newSetFlags(cmd *cobra.Command) {
@@ -80,6 +83,8 @@ func SetSSMParameter(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("value required: provide as argument or use --stdin")
 	}
 
+	cmdutil.RedactArg(value)
+
 	// Validate type
 	validTypes := map[string]bool{"String": true, "StringList": true, "SecureString": true}
 	if !validTypes[setType] {
@@ -97,11 +102,18 @@ func SetSSMParameter(cmd *cobra.Command, args []string) error {
 	}
 
 	if setDryRun {
+		after := value
+		if setType == string(types.ParameterTypeSecureString) {
+			after = maskedValue
+		}
+		changes := []cmdutil.Change{
+			{Resource: paramName, Field: "Value", Before: planValue(existingParam), After: after},
+			{Resource: paramName, Field: "Type", After: setType},
+		}
 		if exists {
-			fmt.Printf("Would update: %s (current type: %s)\n", paramName, existingParam.Type)
-		} else {
-			fmt.Printf("Would create: %s (type: %s)\n", paramName, setType)
+			changes[1].Before = string(existingParam.Type)
 		}
+		cmdutil.RenderPlan(changes)
 		return nil
 	}
 
@@ -134,6 +146,7 @@ func SetSSMParameter(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("put parameter: %w", err)
 	}
+	recordParameterChange(cmd, paramName, existingParam)
 
 	if exists {
 		fmt.Printf("Updated: %s\n", paramName)
@@ -3,6 +3,7 @@ package ssm
 import (
 	"fmt"
 
+	"github.com/harleymckenzie/asc/cmd/completion"
 	"github.com/harleymckenzie/asc/internal/service/ssm"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
@@ -11,6 +12,7 @@ import (
 
 func init() {
 	newUnlabelFlags(unlabelCmd)
+	cmdutil.BypassCache(unlabelCmd)
 }
 
 var unlabelCmd = &cobra.Command{
@@ -27,10 +29,11 @@ You don't need to specify the version number.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdutil.DefaultErrorHandler(UnlabelParameterVersion(cmd, args))
 	},
+	ValidArgsFunction: completion.ParameterNames(1),
 }
 
 func newUnlabelFlags(cmd *cobra.Command) {
-	// No flags needed
+	cmdutil.AllowDryRun(cmd)
 }
 
 func UnlabelParameterVersion(cmd *cobra.Command, args []string) error {
@@ -44,6 +47,15 @@ func UnlabelParameterVersion(cmd *cobra.Command, args []string) error {
 	paramName := args[0]
 	labels := args[1:]
 
+	if cmdutil.IsDryRun(cmd) {
+		current, _, err := labelVersions(ctx, svc, paramName)
+		if err != nil {
+			return fmt.Errorf("get parameter history: %w", err)
+		}
+		cmdutil.RenderPlan(labelPlan(paramName, labels, current, 0))
+		return nil
+	}
+
 	invalidLabels, err := svc.UnlabelParameterVersion(ctx, &ascTypes.UnlabelParameterVersionInput{
 		Name:   paramName,
 		Labels: labels,
@@ -60,6 +72,7 @@ func UnlabelParameterVersion(cmd *cobra.Command, args []string) error {
 	validCount := len(labels) - len(invalidLabels)
 	if validCount > 0 {
 		fmt.Printf("Removed %d label(s) from %s\n", validCount, paramName)
+		cmdutil.RecordChange(cmd, parameterURI(paramName), "", nil)
 	}
 
 	return nil
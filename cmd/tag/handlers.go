@@ -0,0 +1,176 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/harleymckenzie/asc/internal/service/cloudformation"
+	"github.com/harleymckenzie/asc/internal/service/ec2"
+	"github.com/harleymckenzie/asc/internal/service/ecs"
+	"github.com/harleymckenzie/asc/internal/service/elasticache"
+	"github.com/harleymckenzie/asc/internal/service/elb"
+	"github.com/harleymckenzie/asc/internal/service/rds"
+	"github.com/harleymckenzie/asc/internal/service/ssm"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// stackWarning is shown before the tags of a CloudFormation stack are changed.
+const stackWarning = `Changing the tags of a CloudFormation stack updates the stack with its previous template.
+CloudFormation applies the tags to every resource in the stack that supports them, and the
+update can fail and roll back.`
+
+func init() {
+	// EC2, including the VPC resources, which are tagged through the EC2 API
+	for _, key := range []string{
+		"ec2/instance", "ec2/volume", "ec2/snapshot", "ec2/image", "ec2/security-group",
+		"vpc/vpc", "vpc/subnet", "vpc/internet-gateway", "vpc/nat-gateway", "vpc/route-table",
+		"vpc/network-acl", "vpc/prefix-list",
+	} {
+		RegisterHandler(key, ec2Handler)
+	}
+
+	// RDS
+	RegisterHandler("rds/instance", newRDSHandler((*rds.RDSService).GetInstanceARN))
+	RegisterHandler("rds/cluster", newRDSHandler((*rds.RDSService).GetClusterARN))
+
+	// CloudFormation
+	RegisterHandler("cf/stack", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+		svc, err := cloudformation.NewCloudFormationService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		t := &Tagger{Warning: stackWarning}
+		t.Tags = func(ctx context.Context) (map[string]string, error) {
+			return svc.GetStackTags(ctx, uri.Resource)
+		}
+		t.Tag = func(ctx context.Context, tags map[string]string) (err error) {
+			t.Started, err = svc.TagStack(ctx, uri.Resource, tags)
+			return err
+		}
+		t.Untag = func(ctx context.Context, keys []string) (err error) {
+			t.Started, err = svc.UntagStack(ctx, uri.Resource, keys)
+			return err
+		}
+		return t, nil
+	})
+
+	// ElastiCache
+	RegisterHandler("elasticache/cluster", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+		svc, err := elasticache.NewElasticacheService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		arn, err := svc.GetClusterARN(ctx, uri.Resource)
+		if err != nil {
+			return nil, err
+		}
+		return resourceTagger(arn, svc.GetTags, svc.TagResource, svc.UntagResource), nil
+	})
+
+	// ELB
+	RegisterHandler("elb/load-balancer", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+		svc, err := elb.NewELBService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		arn, err := svc.GetLoadBalancerARN(ctx, uri.Resource)
+		if err != nil {
+			return nil, err
+		}
+		return resourceTagger(arn, svc.GetTags, svc.TagResource, svc.UntagResource), nil
+	})
+
+	// ECS
+	RegisterHandler("ecs/cluster", newECSHandler(func(ctx context.Context, svc *ecs.ECSService, uri *awsutil.ResourceURI) (string, error) {
+		return svc.GetClusterARN(ctx, uri.Resource)
+	}))
+	RegisterHandler("ecs/service", newECSHandler(func(ctx context.Context, svc *ecs.ECSService, uri *awsutil.ResourceURI) (string, error) {
+		return svc.GetServiceARN(ctx, uri.Params["cluster"], uri.Resource)
+	}))
+	RegisterHandler("ecs/task", newECSHandler(func(ctx context.Context, svc *ecs.ECSService, uri *awsutil.ResourceURI) (string, error) {
+		return svc.GetTaskARN(ctx, uri.Params["cluster"], uri.Resource)
+	}))
+	RegisterHandler("ecs/task-definition", newECSHandler(func(ctx context.Context, svc *ecs.ECSService, uri *awsutil.ResourceURI) (string, error) {
+		return svc.GetTaskDefinitionARN(ctx, uri.Resource)
+	}))
+
+	// SSM
+	RegisterHandler("ssm/parameter", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+		svc, err := ssm.NewSSMService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		return &Tagger{
+			Tags: func(ctx context.Context) (map[string]string, error) {
+				return svc.GetParameterTags(ctx, uri.Resource)
+			},
+			Tag: func(ctx context.Context, tags map[string]string) error {
+				return svc.TagParameter(ctx, uri.Resource, tags)
+			},
+			Untag: func(ctx context.Context, keys []string) error {
+				return svc.UntagParameter(ctx, uri.Resource, keys)
+			},
+		}, nil
+	})
+}
+
+// ec2Handler tags EC2 resources by their ID.
+func ec2Handler(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+	svc, err := ec2.NewEC2Service(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+	return resourceTagger(uri.Resource, svc.GetTags, svc.TagResource, svc.UntagResource), nil
+}
+
+// newRDSHandler creates a handler for RDS resources, which are tagged by the ARN returned by
+// getARN.
+func newRDSHandler(getARN func(svc *rds.RDSService, ctx context.Context, id string) (string, error)) TagHandler {
+	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+		svc, err := rds.NewRDSService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		arn, err := getARN(svc, ctx, uri.Resource)
+		if err != nil {
+			return nil, err
+		}
+		return resourceTagger(arn, svc.GetTags, svc.TagResource, svc.UntagResource), nil
+	}
+}
+
+// newECSHandler creates a handler for ECS resources, which are tagged by the ARN returned by
+// getARN.
+func newECSHandler(getARN func(ctx context.Context, svc *ecs.ECSService, uri *awsutil.ResourceURI) (string, error)) TagHandler {
+	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+		svc, err := ecs.NewECSService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		arn, err := getARN(ctx, svc, uri)
+		if err != nil {
+			return nil, err
+		}
+		return resourceTagger(arn, svc.GetTags, svc.TagResource, svc.UntagResource), nil
+	}
+}
+
+// resourceTagger creates a Tagger from a service's get, tag and untag functions for a single
+// resource ID or ARN.
+func resourceTagger(
+	id string,
+	get func(ctx context.Context, id string) (map[string]string, error),
+	tag func(ctx context.Context, id string, tags map[string]string) error,
+	untag func(ctx context.Context, id string, keys []string) error,
+) *Tagger {
+	return &Tagger{
+		Tags: func(ctx context.Context) (map[string]string, error) {
+			return get(ctx, id)
+		},
+		Tag: func(ctx context.Context, tags map[string]string) error {
+			return tag(ctx, id, tags)
+		},
+		Untag: func(ctx context.Context, keys []string) error {
+			return untag(ctx, id, keys)
+		},
+	}
+}
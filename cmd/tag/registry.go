@@ -0,0 +1,44 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// Tagger reads, adds and removes tags on a single resource. Tags returns the current tags, for
+// the plans shown with --dry-run.
+type Tagger struct {
+	Tags  func(ctx context.Context) (map[string]string, error)
+	Tag   func(ctx context.Context, tags map[string]string) error
+	Untag func(ctx context.Context, keys []string) error
+
+	// Warning is shown before the resource is changed, and the change is then confirmed unless
+	// --yes is set. It is empty for resources that are tagged without side effects.
+	Warning string
+	// Started is set by Tag and Untag when they start an update that continues after they
+	// return, such as a CloudFormation stack update.
+	Started bool
+}
+
+// TagHandler returns a Tagger for the resource identified by the URI.
+type TagHandler func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error)
+
+var handlers = map[string]TagHandler{}
+
+// RegisterHandler registers a tag handler for a service/resourceType combination.
+// key format: "service/resourceType" (e.g. "ec2/instance", "rds/cluster").
+func RegisterHandler(key string, handler TagHandler) {
+	handlers[key] = handler
+}
+
+// getHandler returns the registered handler for a ResourceURI, or an error if none exists.
+func getHandler(uri *awsutil.ResourceURI) (TagHandler, error) {
+	key := fmt.Sprintf("%s/%s", uri.Service, uri.ResourceType)
+	handler, ok := handlers[key]
+	if !ok {
+		return nil, fmt.Errorf("tagging is not supported for %s %s", uri.Service, uri.ResourceType)
+	}
+	return handler, nil
+}
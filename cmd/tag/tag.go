@@ -0,0 +1,405 @@
+package tag
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/selection"
+	"github.com/spf13/cobra"
+)
+
+var fromFile string
+
+const resourceHelp = `Resources are given as protocol-style URIs, or IDs with a known prefix:
+  ec2://i-xxx, vol-xxx, snap-xxx, ami-xxx, sg-xxx   EC2 resources
+  vpc-xxx, subnet-xxx, igw-xxx, nat-xxx, rtb-xxx   VPC resources
+  rds://my-database, rds://cluster/my-cluster      RDS instances and clusters
+  elb://my-lb                                      ELB load balancer
+  ecs://cluster/my-cluster                         ECS cluster
+  ecs://service/my-cluster/my-service              ECS service
+  ecs://task/my-cluster/task-id                    ECS task
+  ecs://task-definition/my-family:1                ECS task definition
+  cf://my-stack                                    CloudFormation stack
+  ssm:///my/parameter                              SSM parameter
+  elasticache://my-cluster                         ElastiCache cluster
+
+CloudFormation stacks are tagged by updating the stack with its previous template, which
+also applies the tags to the stack's resources. The update is confirmed first unless --yes is
+set, stacks with an operation in progress are refused, and the command returns once the update
+has started.
+
+With @, the resources selected with 'asc select' are used. They are listed for confirmation
+first, unless --yes is set.`
+
+// NewTagCmd creates the top-level tag command.
+func NewTagCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag <protocol://resource...> <Key=Value...>",
+		Short: "Add or update tags on AWS resources",
+		Long: `Add or update tags on one or more AWS resources. Existing tags with the same keys
+are overwritten.
+
+` + resourceHelp + `
+
+With --from-file, each line of the file is a resource followed by the tags for that resource.
+Blank lines and lines starting with # are ignored, and values with spaces can be quoted.
+Tags given on the command line are applied to every resource in the file:
+  i-1234567890abcdef0 Env=prod Owner=web
+  rds://my-database Env=prod "Cost Centre=Platform Team"`,
+		Example: `  asc tag i-1234567890abcdef0 Env=prod Owner=web
+  asc tag rds://my-database rds://cluster/my-cluster Env=prod
+  asc tag ecs://service/my-cluster/my-service Team=platform
+  asc tag --from-file tags.txt
+  asc tag --from-file instances.txt Backup=daily
+  asc tag @ Env=prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(runTag(cmd, args))
+		},
+	}
+	cmd.Flags().StringVarP(&fromFile, "from-file", "f", "", "Read resources and tags from a file, one resource per line (- for stdin)")
+	cmdutil.AddYesFlag(cmd)
+	cmdutil.AllowDryRun(cmd)
+	cmdutil.BypassCache(cmd)
+	return cmd
+}
+
+// NewUntagCmd creates the top-level untag command.
+func NewUntagCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "untag <protocol://resource...> <Key...>",
+		Short: "Remove tags from AWS resources",
+		Long: `Remove tags from one or more AWS resources.
+
+Arguments are read as resources until the first argument that is not a resource, and the
+rest are tag keys. Use -- to separate the resources from the keys when a key looks like a
+resource ID.
+
+` + resourceHelp + `
+
+With --from-file, each line of the file is a resource followed by the tag keys to remove.
+Blank lines and lines starting with # are ignored. Keys given on the command line are removed
+from every resource in the file.`,
+		Example: `  asc untag i-1234567890abcdef0 Env Owner
+  asc untag rds://my-database -- fs-owner
+  asc untag --from-file untag.txt
+  asc untag --from-file instances.txt Backup
+  asc untag @ Env`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(runUntag(cmd, args))
+		},
+	}
+	cmd.Flags().StringVarP(&fromFile, "from-file", "f", "", "Read resources and tag keys from a file, one resource per line (- for stdin)")
+	cmdutil.AddYesFlag(cmd)
+	cmdutil.AllowDryRun(cmd)
+	cmdutil.BypassCache(cmd)
+	return cmd
+}
+
+// entry is a resource and the tags to add or the tag keys to remove.
+type entry struct {
+	input string
+	uri   *awsutil.ResourceURI
+	tags  map[string]string
+	keys  []string
+}
+
+func runTag(cmd *cobra.Command, args []string) error {
+	var resources []string
+	tags := map[string]string{}
+	for _, arg := range args {
+		if strings.Contains(arg, "=") {
+			key, value, err := parseTag(arg)
+			if err != nil {
+				return err
+			}
+			tags[key] = value
+			continue
+		}
+		resources = append(resources, arg)
+	}
+	resources, ok, err := cmdutil.ConfirmSelection(cmd, resources, "Tag")
+	if err != nil || !ok {
+		return err
+	}
+
+	entries, err := getEntries(cmd, resources, func(e *entry, fields []string) error {
+		var err error
+		if e.tags, err = mergeTags(tags, fields); err != nil {
+			return err
+		}
+		if len(e.tags) == 0 {
+			return fmt.Errorf("no tags given for %s, expected Key=Value", e.input)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return apply(cmd, entries, "Tag", "Tagged", func(e entry, t *Tagger) (string, error) {
+		if err := t.Tag(cmd.Context(), e.tags); err != nil {
+			return "", fmt.Errorf("tag %s: %w", e.input, err)
+		}
+		var pairs []string
+		for _, key := range slices.Sorted(maps.Keys(e.tags)) {
+			pairs = append(pairs, key+"="+e.tags[key])
+		}
+		return strings.Join(pairs, ", "), nil
+	})
+}
+
+func runUntag(cmd *cobra.Command, args []string) error {
+	resources, keys := splitUntagArgs(args, cmd.ArgsLenAtDash())
+	resources, ok, err := cmdutil.ConfirmSelection(cmd, resources, "Untag")
+	if err != nil || !ok {
+		return err
+	}
+
+	entries, err := getEntries(cmd, resources, func(e *entry, fields []string) error {
+		e.keys = append(slices.Clone(keys), fields...)
+		if len(e.keys) == 0 {
+			return fmt.Errorf("no tag keys given for %s", e.input)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return apply(cmd, entries, "Untag", "Untagged", func(e entry, t *Tagger) (string, error) {
+		if err := t.Untag(cmd.Context(), e.keys); err != nil {
+			return "", fmt.Errorf("untag %s: %w", e.input, err)
+		}
+		return strings.Join(e.keys, ", "), nil
+	})
+}
+
+// splitUntagArgs splits the arguments of untag into resources and tag keys. The keys start at
+// dash, the position of --, if given, and otherwise at the first argument that is not a
+// resource.
+func splitUntagArgs(args []string, dash int) ([]string, []string) {
+	if dash >= 0 {
+		return args[:dash], args[dash:]
+	}
+	i := 0
+	for ; i < len(args); i++ {
+		if args[i] == selection.Placeholder {
+			continue
+		}
+		if _, err := awsutil.ParseResourceURI(args[i]); err != nil {
+			break
+		}
+	}
+	return args[:i], args[i:]
+}
+
+// getEntries returns an entry for each resource given as an argument and each line of the
+// --from-file file. The selection has already been resolved in the arguments. setTags is called with the fields following the resource on its line to
+// fill in the entry's tags or keys.
+func getEntries(cmd *cobra.Command, resources []string, setTags func(e *entry, fields []string) error) ([]entry, error) {
+	type line struct {
+		resource string
+		fields   []string
+	}
+	var lines []line
+	for _, resource := range resources {
+		lines = append(lines, line{resource: resource})
+	}
+
+	if fromFile != "" {
+		fileLines, err := readFile(cmd, fromFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, fields := range fileLines {
+			lines = append(lines, line{resource: fields[0], fields: fields[1:]})
+		}
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no resources given. Pass a resource, or @ for the selected resources")
+	}
+
+	var entries []entry
+	for _, l := range lines {
+		uri, err := awsutil.ParseResourceURI(l.resource)
+		if err != nil {
+			return nil, err
+		}
+		e := entry{input: l.resource, uri: uri}
+		if err := setTags(&e, l.fields); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// apply runs fn for the Tagger of each entry, and reports the tags or keys it returns as done.
+// Resources with a warning, such as CloudFormation stacks, are confirmed first unless --yes is
+// set, and skipped if the user declines. With --dry-run, the changes to the tags of every entry
+// are shown as a single plan instead. Every entry is attempted, and the errors are returned
+// together at the end.
+func apply(cmd *cobra.Command, entries []entry, action, done string, fn func(e entry, t *Tagger) (string, error)) error {
+	ctx := cmd.Context()
+	profile, region := cmdutil.GetPersistentFlags(cmd)
+	yes, _ := cmd.Flags().GetBool("yes")
+	dryRun := cmdutil.IsDryRun(cmd)
+
+	var plan []cmdutil.Change
+	var errs []error
+	for _, e := range entries {
+		handler, err := getHandler(e.uri)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		tagger, err := handler(ctx, profile, region, e.uri)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", e.input, err))
+			continue
+		}
+		if dryRun {
+			current, err := tagger.Tags(ctx)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("get tags of %s: %w", e.input, err))
+				continue
+			}
+			plan = append(plan, tagPlan(e, current)...)
+			continue
+		}
+		if tagger.Warning != "" && !yes {
+			fmt.Println(tagger.Warning)
+			ok, err := cmdutil.Confirm(fmt.Sprintf("%s %s?", action, e.input))
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+		}
+		detail, err := fn(e, tagger)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if tagger.Started {
+			fmt.Printf("Started an update of %s to %s it: %s. Run 'asc wait %s' to wait for it to complete\n",
+				e.input, strings.ToLower(action), detail, e.uri)
+		} else {
+			fmt.Printf("%s %s: %s\n", done, e.input, detail)
+		}
+		cmdutil.RecordChange(cmd, e.uri.String(), "", nil)
+	}
+	if len(plan) > 0 {
+		cmdutil.RenderPlan(plan)
+	}
+	return errors.Join(errs...)
+}
+
+// tagPlan returns the changes to the current tags of a resource made by adding the tags or
+// removing the keys of an entry.
+func tagPlan(e entry, current map[string]string) []cmdutil.Change {
+	var changes []cmdutil.Change
+	for _, key := range slices.Sorted(maps.Keys(e.tags)) {
+		changes = append(changes, cmdutil.Change{Resource: e.input, Field: "Tag: " + key, Before: current[key], After: e.tags[key]})
+	}
+	for _, key := range e.keys {
+		changes = append(changes, cmdutil.Change{Resource: e.input, Field: "Tag: " + key, Before: current[key]})
+	}
+	return changes
+}
+
+// parseTag parses a tag in the form Key=Value. The value may be empty.
+func parseTag(s string) (string, string, error) {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || key == "" {
+		return "", "", fmt.Errorf("invalid tag %q, expected Key=Value", s)
+	}
+	return key, value, nil
+}
+
+// mergeTags returns a copy of tags with the Key=Value fields added. Fields overwrite tags with
+// the same key, and later fields overwrite earlier ones.
+func mergeTags(tags map[string]string, fields []string) (map[string]string, error) {
+	merged := make(map[string]string, len(tags)+len(fields))
+	maps.Copy(merged, tags)
+	for _, field := range fields {
+		key, value, err := parseTag(field)
+		if err != nil {
+			return nil, err
+		}
+		merged[key] = value
+	}
+	return merged, nil
+}
+
+// readFile reads the non-empty lines of the file, or stdin for "-", split into fields.
+func readFile(cmd *cobra.Command, path string) ([][]string, error) {
+	var r io.Reader = cmd.InOrStdin()
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", path, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var lines [][]string
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields, err := splitFields(text)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, n, err)
+		}
+		lines = append(lines, fields)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return lines, nil
+}
+
+// splitFields splits a line on whitespace. Double quotes group text containing spaces and are
+// removed, so `"Cost Centre=Platform Team"` and `Owner="Web Team"` are single fields.
+func splitFields(line string) ([]string, error) {
+	var fields []string
+	var field strings.Builder
+	inField, quoted := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inField = true
+		case (r == ' ' || r == '\t') && !quoted:
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteRune(r)
+			inField = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+	return fields, nil
+}
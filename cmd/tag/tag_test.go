@@ -0,0 +1,278 @@
+package tag
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/selection"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Unit test for splitFields
+func TestSplitFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "fields", line: "i-0abc Env=prod Owner=web", want: []string{"i-0abc", "Env=prod", "Owner=web"}},
+		{name: "tabs and repeated spaces", line: "i-0abc\tEnv=prod   Owner=web", want: []string{"i-0abc", "Env=prod", "Owner=web"}},
+		{name: "quoted field", line: `i-0abc "Cost Centre=Platform Team"`, want: []string{"i-0abc", "Cost Centre=Platform Team"}},
+		{name: "quoted value", line: `i-0abc Owner="Web Team"`, want: []string{"i-0abc", "Owner=Web Team"}},
+		{name: "empty quoted value", line: `i-0abc Owner=""`, want: []string{"i-0abc", "Owner="}},
+		{name: "empty quoted field", line: `i-0abc ""`, want: []string{"i-0abc", ""}},
+		{name: "equals in value", line: "i-0abc Query=a=b", want: []string{"i-0abc", "Query=a=b"}},
+		{name: "empty", line: "", want: nil},
+		{name: "unterminated quote", line: `i-0abc Owner="Web Team`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitFields(tt.line)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// Unit test for parseTag
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantKey   string
+		wantValue string
+		wantErr   bool
+	}{
+		{input: "Env=prod", wantKey: "Env", wantValue: "prod"},
+		{input: "Env=", wantKey: "Env", wantValue: ""},
+		{input: "Query=a=b", wantKey: "Query", wantValue: "a=b"},
+		{input: "Cost Centre=Platform Team", wantKey: "Cost Centre", wantValue: "Platform Team"},
+		{input: "=prod", wantErr: true},
+		{input: "Env", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			key, value, err := parseTag(tt.input)
+			if tt.wantErr {
+				assert.EqualError(t, err, `invalid tag "`+tt.input+`", expected Key=Value`)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKey, key)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+}
+
+// Unit test for mergeTags
+func TestMergeTags(t *testing.T) {
+	tags := map[string]string{"Env": "prod", "Owner": "web"}
+
+	merged, err := mergeTags(tags, []string{"Env=dev", "Team=platform", "Team=data"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Env": "dev", "Owner": "web", "Team": "data"}, merged,
+		"fields overwrite tags with the same key, and later fields win")
+	assert.Equal(t, map[string]string{"Env": "prod", "Owner": "web"}, tags, "tags are not modified")
+
+	merged, err = mergeTags(nil, []string{"Env="})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Env": ""}, merged)
+
+	_, err = mergeTags(tags, []string{"Env"})
+	assert.Error(t, err)
+}
+
+// Unit test for splitUntagArgs
+func TestSplitUntagArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		dash          int
+		wantResources []string
+		wantKeys      []string
+	}{
+		{name: "resources then keys", args: []string{"i-0abc", "rds://my-db", "Env", "Owner"}, dash: -1, wantResources: []string{"i-0abc", "rds://my-db"}, wantKeys: []string{"Env", "Owner"}},
+		{name: "selection", args: []string{"@", "Env"}, dash: -1, wantResources: []string{"@"}, wantKeys: []string{"Env"}},
+		{name: "keys only", args: []string{"Env"}, dash: -1, wantResources: []string{}, wantKeys: []string{"Env"}},
+		{name: "key that looks like a resource", args: []string{"rds://my-db", "fs-owner"}, dash: -1, wantResources: []string{"rds://my-db", "fs-owner"}, wantKeys: []string{}},
+		{name: "dash separates keys", args: []string{"rds://my-db", "fs-owner"}, dash: 1, wantResources: []string{"rds://my-db"}, wantKeys: []string{"fs-owner"}},
+		{name: "dash with no resources", args: []string{"Env"}, dash: 0, wantResources: []string{}, wantKeys: []string{"Env"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources, keys := splitUntagArgs(tt.args, tt.dash)
+			assert.Equal(t, tt.wantResources, resources)
+			assert.Equal(t, tt.wantKeys, keys)
+		})
+	}
+}
+
+// useFile sets --from-file to a file with the given contents.
+func useFile(t *testing.T, contents string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "tags.txt")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	file := fromFile
+	fromFile = path
+	t.Cleanup(func() { fromFile = file })
+}
+
+// tagEntries reads the entries of --from-file the way the tag command does.
+func tagEntries(t *testing.T, tags map[string]string) ([]entry, error) {
+	t.Helper()
+	return getEntries(&cobra.Command{}, nil, func(e *entry, fields []string) error {
+		var err error
+		e.tags, err = mergeTags(tags, fields)
+		return err
+	})
+}
+
+// Unit test for reading resources and tags with --from-file
+func TestFromFile(t *testing.T) {
+	useFile(t, strings.Join([]string{
+		"# Production instances",
+		"i-0abc Env=prod Owner=web",
+		"",
+		`rds://my-db "Cost Centre=Platform Team" Env=`,
+		"vol-0abc Env=prod Env=dev Query=a=b",
+		"  sg-0abc   Backup=daily  ",
+	}, "\n"))
+
+	entries, err := tagEntries(t, map[string]string{"Backup": "weekly"})
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, "i-0abc", entries[0].input)
+	assert.Equal(t, "ec2://instance/i-0abc", entries[0].uri.String())
+	assert.Equal(t, map[string]string{"Env": "prod", "Owner": "web", "Backup": "weekly"}, entries[0].tags)
+
+	assert.Equal(t, "rds://instance/my-db", entries[1].uri.String())
+	assert.Equal(t, map[string]string{"Cost Centre": "Platform Team", "Env": "", "Backup": "weekly"}, entries[1].tags)
+
+	assert.Equal(t, map[string]string{"Env": "dev", "Query": "a=b", "Backup": "weekly"}, entries[2].tags,
+		"the last value of a duplicate key is used")
+
+	assert.Equal(t, "sg-0abc", entries[3].input)
+	assert.Equal(t, map[string]string{"Backup": "daily"}, entries[3].tags, "tags in the file overwrite the command line")
+}
+
+// Unit test for the errors of malformed --from-file files
+func TestFromFileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+	}{
+		{name: "unterminated quote", contents: "i-0abc Env=prod\ni-0def Owner=\"Web Team\n", wantErr: "line 2: unterminated quote"},
+		{name: "invalid tag", contents: "i-0abc Env\n", wantErr: `invalid tag "Env", expected Key=Value`},
+		{name: "empty key", contents: "i-0abc =prod\n", wantErr: `invalid tag "=prod", expected Key=Value`},
+		{name: "unknown resource", contents: "my-database Env=prod\n", wantErr: `cannot determine service for "my-database"`},
+		{name: "no resources", contents: "# nothing to tag\n\n", wantErr: "no resources given"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useFile(t, tt.contents)
+			_, err := tagEntries(t, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	file := fromFile
+	fromFile = filepath.Join(t.TempDir(), "missing.txt")
+	t.Cleanup(func() { fromFile = file })
+	_, err := tagEntries(t, nil)
+	assert.ErrorContains(t, err, "open ")
+}
+
+// Unit test for reading --from-file from stdin
+func TestFromFileStdin(t *testing.T) {
+	file := fromFile
+	fromFile = "-"
+	t.Cleanup(func() { fromFile = file })
+
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader("i-0abc Env=prod\n"))
+	entries, err := getEntries(cmd, nil, func(e *entry, fields []string) error {
+		e.keys = fields
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, []string{"Env=prod"}, entries[0].keys)
+}
+
+// Unit test for tag and untag requiring @ to act on the selection
+func TestSelectionRequiresPlaceholder(t *testing.T) {
+	t.Setenv("ASC_SELECTION", filepath.Join(t.TempDir(), "selection.json"))
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_REGION", "eu-west-1")
+	uri, err := awsutil.ParseResourceURI("i-0abc")
+	require.NoError(t, err)
+	require.NoError(t, selection.Save(selection.Scope{Region: "eu-west-1"}, []*awsutil.ResourceURI{uri}))
+
+	err = runTag(NewTagCmd(), []string{"Env=prod"})
+	assert.ErrorContains(t, err, "no resources given")
+	err = runUntag(NewUntagCmd(), []string{"Env"})
+	assert.ErrorContains(t, err, "no resources given")
+}
+
+// Unit test for tagPlan
+func TestTagPlan(t *testing.T) {
+	current := map[string]string{"Env": "dev", "Owner": "web"}
+
+	changes := tagPlan(entry{input: "i-0abc", tags: map[string]string{"Team": "data", "Env": "prod"}}, current)
+	assert.Equal(t, []cmdutil.Change{
+		{Resource: "i-0abc", Field: "Tag: Env", Before: "dev", After: "prod"},
+		{Resource: "i-0abc", Field: "Tag: Team", Before: "", After: "data"},
+	}, changes)
+
+	changes = tagPlan(entry{input: "i-0abc", keys: []string{"Owner", "Missing"}}, current)
+	assert.Equal(t, []cmdutil.Change{
+		{Resource: "i-0abc", Field: "Tag: Owner", Before: "web"},
+		{Resource: "i-0abc", Field: "Tag: Missing"},
+	}, changes)
+}
+
+// Unit test for apply making no changes with --dry-run
+func TestApplyDryRun(t *testing.T) {
+	registered := handlers
+	t.Cleanup(func() { handlers = registered })
+	var tagged bool
+	handlers = map[string]TagHandler{
+		"ec2/instance": func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Tagger, error) {
+			return &Tagger{
+				Tags: func(ctx context.Context) (map[string]string, error) { return map[string]string{"Env": "dev"}, nil },
+				Tag: func(ctx context.Context, tags map[string]string) error {
+					tagged = true
+					return nil
+				},
+				Warning: "confirmation is not asked for with --dry-run",
+			}, nil
+		},
+	}
+
+	root := &cobra.Command{Use: "asc"}
+	cmdutil.AddDryRunFlag(root)
+	cmd := NewTagCmd()
+	root.AddCommand(cmd)
+	require.NoError(t, cmd.ParseFlags([]string{"--dry-run"}))
+
+	uri, err := awsutil.ParseResourceURI("i-0abc")
+	require.NoError(t, err)
+	err = apply(cmd, []entry{{input: "i-0abc", uri: uri, tags: map[string]string{"Env": "prod"}}}, "Tag", "Tagged",
+		func(e entry, t *Tagger) (string, error) { return "", t.Tag(context.Background(), e.tags) })
+	require.NoError(t, err)
+	assert.False(t, tagged)
+}
@@ -0,0 +1,268 @@
+package undo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/harleymckenzie/asc/internal/service/asg"
+	asgTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
+	"github.com/harleymckenzie/asc/internal/service/ec2"
+	ec2Types "github.com/harleymckenzie/asc/internal/service/ec2/types"
+	"github.com/harleymckenzie/asc/internal/service/rds"
+	rdsTypes "github.com/harleymckenzie/asc/internal/service/rds/types"
+	"github.com/harleymckenzie/asc/internal/service/ssm"
+	ascSSMTypes "github.com/harleymckenzie/asc/internal/service/ssm/types"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+)
+
+// The service constructors used by the handlers, replaced in tests.
+var (
+	newEC2Service         = ec2.NewEC2Service
+	newAutoScalingService = asg.NewAutoScalingService
+	newRDSService         = rds.NewRDSService
+	newSSMService         = ssm.NewSSMService
+)
+
+func init() {
+	// EC2: a stop or start is undone by returning the instance to its state before the change
+	RegisterHandler("ec2/stop", newEC2StateHandler("stopped"))
+	RegisterHandler("ec2/start", newEC2StateHandler("running"))
+
+	// Auto Scaling
+	RegisterHandler("asg/modify", asgCapacityHandler)
+	RegisterHandler("asg/schedule", asgScheduleHandler)
+
+	// RDS
+	RegisterHandler("rds/modify", rdsModifyHandler)
+
+	// SSM
+	RegisterHandler("ssm/parameter", ssmParameterHandler)
+}
+
+// ec2StateRestorers move an instance to a stable state, keyed by that state, and name the
+// handler that reverses the move.
+var ec2StateRestorers = map[string]struct {
+	undo  string
+	apply func(ctx context.Context, svc *ec2.EC2Service, id string) error
+}{
+	"running": {
+		undo: "ec2/start",
+		apply: func(ctx context.Context, svc *ec2.EC2Service, id string) error {
+			return svc.StartInstance(ctx, &ec2Types.StartInstanceInput{InstanceID: id})
+		},
+	},
+	"stopped": {
+		undo: "ec2/stop",
+		apply: func(ctx context.Context, svc *ec2.EC2Service, id string) error {
+			return svc.StopInstance(ctx, &ec2Types.StopInstanceInput{InstanceID: id})
+		},
+	},
+}
+
+// newEC2StateHandler returns an UndoHandler that returns an instance to the state recorded before
+// a change that moved it to target, such as "stopped" for a stop. A change to an instance that
+// was already in the target state had no effect, so there is nothing to undo. An instance that
+// was in a transitional state, such as pending or stopping, has no state to return to.
+func newEC2StateHandler(target string) UndoHandler {
+	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI, before map[string]string) (*Reversal, error) {
+		recorded := before["state"]
+		if recorded == target {
+			return &Reversal{
+				Note: fmt.Sprintf("Instance %s was already %s, so the change had no effect and there is nothing to undo.", uri.Resource, target),
+			}, nil
+		}
+		restorer, ok := ec2StateRestorers[recorded]
+		if !ok {
+			if recorded == "" {
+				return nil, fmt.Errorf("no state was recorded for instance %s before the change", uri.Resource)
+			}
+			return nil, fmt.Errorf("instance %s was %s before the change, which is not a state it can be returned to", uri.Resource, recorded)
+		}
+
+		svc, err := newEC2Service(ctx, profile, region)
+		if err != nil {
+			return nil, fmt.Errorf("create new EC2 service: %w", err)
+		}
+		current, err := svc.GetInstanceStatus(ctx, uri.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("get instance state: %w", err)
+		}
+		return &Reversal{
+			Changes: stateChanges(uri.Resource, map[string]string{"state": current}, map[string]string{"state": recorded}),
+			Apply: func(ctx context.Context) error {
+				return restorer.apply(ctx, svc, uri.Resource)
+			},
+			Undo:   restorer.undo,
+			Before: map[string]string{"state": current},
+		}, nil
+	}
+}
+
+// asgCapacityHandler restores the min, max and desired capacity of an Auto Scaling Group.
+func asgCapacityHandler(ctx context.Context, profile, region string, uri *awsutil.ResourceURI, before map[string]string) (*Reversal, error) {
+	svc, err := newAutoScalingService(ctx, profile, region)
+	if err != nil {
+		return nil, fmt.Errorf("create new Auto Scaling Service: %w", err)
+	}
+	groups, err := svc.GetAutoScalingGroups(ctx, &asgTypes.GetAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{uri.Resource},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get Auto Scaling Groups: %w", err)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("Auto Scaling Group not found: %s", uri.Resource)
+	}
+	input, err := asg.CapacityInputFromState(uri.Resource, before)
+	if err != nil {
+		return nil, err
+	}
+
+	current := asg.CapacityState(groups[0])
+	return &Reversal{
+		Changes: stateChanges(uri.Resource, current, before),
+		Apply: func(ctx context.Context) error {
+			return svc.ModifyAutoScalingGroup(ctx, input)
+		},
+		Undo:   "asg/modify",
+		Before: current,
+	}, nil
+}
+
+// asgScheduleHandler restores a scheduled action of an Auto Scaling Group, or removes it if it
+// did not exist.
+func asgScheduleHandler(ctx context.Context, profile, region string, uri *awsutil.ResourceURI, before map[string]string) (*Reversal, error) {
+	svc, err := newAutoScalingService(ctx, profile, region)
+	if err != nil {
+		return nil, fmt.Errorf("create new Auto Scaling Service: %w", err)
+	}
+	asgName := uri.Params["group"]
+	schedules, err := svc.GetAutoScalingGroupSchedules(ctx, &asgTypes.GetAutoScalingGroupSchedulesInput{
+		AutoScalingGroupName: asgName,
+		ScheduledActionNames: []string{uri.Resource},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get schedule: %w", err)
+	}
+	current := map[string]string{}
+	if len(schedules) > 0 {
+		current = asg.ScheduleState(schedules[0])
+	}
+
+	reversal := &Reversal{
+		Changes: stateChanges(uri.Resource, current, before),
+		Undo:    "asg/schedule",
+		Before:  current,
+	}
+	if len(before) == 0 {
+		reversal.Apply = func(ctx context.Context) error {
+			return svc.RemoveAutoScalingGroupSchedule(ctx, &asgTypes.RemoveAutoScalingGroupScheduleInput{
+				AutoScalingGroupName: asgName,
+				ScheduledActionName:  uri.Resource,
+			})
+		}
+		return reversal, nil
+	}
+
+	input, err := asg.ScheduleInputFromState(asgName, uri.Resource, before)
+	if err != nil {
+		return nil, err
+	}
+	reversal.Apply = func(ctx context.Context) error {
+		return svc.AddAutoScalingGroupSchedule(ctx, input)
+	}
+	return reversal, nil
+}
+
+// rdsModifyHandler restores the instance class and maintenance window of an RDS instance. The
+// change is applied immediately if the original change was.
+func rdsModifyHandler(ctx context.Context, profile, region string, uri *awsutil.ResourceURI, before map[string]string) (*Reversal, error) {
+	svc, err := newRDSService(ctx, profile, region)
+	if err != nil {
+		return nil, fmt.Errorf("create new RDS Service: %w", err)
+	}
+	instances, err := svc.GetInstances(ctx, &rdsTypes.GetInstancesInput{InstanceIdentifier: uri.Resource})
+	if err != nil {
+		return nil, fmt.Errorf("get instance: %w", err)
+	}
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("RDS instance not found: %s", uri.Resource)
+	}
+	instance := instances[0]
+
+	input := &rdsTypes.ModifyInstanceInput{DBInstanceIdentifier: aws.String(uri.Resource)}
+	current := map[string]string{"apply_immediately": before["apply_immediately"]}
+	if class, ok := before["instance_class"]; ok {
+		input.DBInstanceClass = aws.String(class)
+		current["instance_class"] = aws.ToString(instance.DBInstanceClass)
+	}
+	if window, ok := before["maintenance_window"]; ok {
+		input.PreferredMaintenanceWindow = aws.String(window)
+		current["maintenance_window"] = aws.ToString(instance.PreferredMaintenanceWindow)
+	}
+	if immediately, _ := strconv.ParseBool(before["apply_immediately"]); immediately {
+		input.ApplyImmediately = aws.Bool(true)
+	}
+
+	return &Reversal{
+		Changes: stateChanges(uri.Resource, current, before),
+		Apply: func(ctx context.Context) error {
+			return svc.ModifyInstance(ctx, input)
+		},
+		Undo:   "rds/modify",
+		Before: current,
+	}, nil
+}
+
+// ssmParameterHandler restores the version of a parameter before a change by copying its value
+// to a new version, or deletes the parameter if it did not exist.
+func ssmParameterHandler(ctx context.Context, profile, region string, uri *awsutil.ResourceURI, before map[string]string) (*Reversal, error) {
+	svc, err := newSSMService(ctx, profile, region)
+	if err != nil {
+		return nil, fmt.Errorf("create ssm service: %w", err)
+	}
+	name := uri.Resource
+	param, err := svc.GetParameter(ctx, &ascSSMTypes.GetParameterInput{Name: name})
+	var notFound *ssmTypes.ParameterNotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return nil, fmt.Errorf("get parameter: %w", err)
+	}
+	current := ssm.ParameterState(param)
+	reversal := &Reversal{Undo: "ssm/parameter", Before: current}
+
+	version, existed := before["version"]
+	switch {
+	case !existed:
+		if param != nil {
+			reversal.Changes = []cmdutil.Change{{Resource: name, Field: "Value", Before: "version " + current["version"]}}
+		}
+		reversal.Apply = func(ctx context.Context) error {
+			return svc.DeleteParameter(ctx, &ascSSMTypes.DeleteParameterInput{Name: name})
+		}
+	case param == nil:
+		return nil, fmt.Errorf("parameter %s has been deleted, so version %s cannot be restored", name, version)
+	default:
+		if current["version"] != version {
+			reversal.Changes = []cmdutil.Change{{
+				Resource: name,
+				Field:    "Value",
+				Before:   "version " + current["version"],
+				After:    "value of version " + version,
+			}}
+		}
+		reversal.Apply = func(ctx context.Context) error {
+			return svc.CopyParameter(ctx, &ascSSMTypes.CopyParameterInput{
+				Source:    name + ":" + version,
+				Dest:      name,
+				Overwrite: true,
+			})
+		}
+	}
+	return reversal, nil
+}
@@ -0,0 +1,406 @@
+package undo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2sdkTypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	rdssdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	rdssdkTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	ssmsdk "github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/harleymckenzie/asc/internal/service/asg"
+	"github.com/harleymckenzie/asc/internal/service/ec2"
+	"github.com/harleymckenzie/asc/internal/service/rds"
+	"github.com/harleymckenzie/asc/internal/service/ssm"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+)
+
+// mockEC2Client serves the state of a single instance and records the instances started and
+// stopped.
+type mockEC2Client struct {
+	ec2.EC2ClientAPI
+	state   string
+	started []string
+	stopped []string
+}
+
+func (m *mockEC2Client) DescribeInstances(ctx context.Context, params *ec2sdk.DescribeInstancesInput, optFns ...func(*ec2sdk.Options)) (*ec2sdk.DescribeInstancesOutput, error) {
+	return &ec2sdk.DescribeInstancesOutput{Reservations: []ec2sdkTypes.Reservation{{
+		Instances: []ec2sdkTypes.Instance{{
+			InstanceId: aws.String(params.InstanceIds[0]),
+			State:      &ec2sdkTypes.InstanceState{Name: ec2sdkTypes.InstanceStateName(m.state)},
+		}},
+	}}}, nil
+}
+
+func (m *mockEC2Client) StartInstances(ctx context.Context, params *ec2sdk.StartInstancesInput, optFns ...func(*ec2sdk.Options)) (*ec2sdk.StartInstancesOutput, error) {
+	m.started = append(m.started, params.InstanceIds...)
+	return &ec2sdk.StartInstancesOutput{}, nil
+}
+
+func (m *mockEC2Client) StopInstances(ctx context.Context, params *ec2sdk.StopInstancesInput, optFns ...func(*ec2sdk.Options)) (*ec2sdk.StopInstancesOutput, error) {
+	m.stopped = append(m.stopped, params.InstanceIds...)
+	return &ec2sdk.StopInstancesOutput{}, nil
+}
+
+// mockAutoScalingClient serves a group and its scheduled actions, and records the updates made.
+type mockAutoScalingClient struct {
+	asg.AutoScalingClientAPI
+	group     autoscalingTypes.AutoScalingGroup
+	schedules []autoscalingTypes.ScheduledUpdateGroupAction
+	updated   *autoscaling.UpdateAutoScalingGroupInput
+	put       *autoscaling.PutScheduledUpdateGroupActionInput
+	deleted   *autoscaling.DeleteScheduledActionInput
+}
+
+func (m *mockAutoScalingClient) DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	return &autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []autoscalingTypes.AutoScalingGroup{m.group}}, nil
+}
+
+func (m *mockAutoScalingClient) UpdateAutoScalingGroup(ctx context.Context, params *autoscaling.UpdateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	m.updated = params
+	return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+}
+
+func (m *mockAutoScalingClient) DescribeScheduledActions(ctx context.Context, params *autoscaling.DescribeScheduledActionsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	return &autoscaling.DescribeScheduledActionsOutput{ScheduledUpdateGroupActions: m.schedules}, nil
+}
+
+func (m *mockAutoScalingClient) PutScheduledUpdateGroupAction(ctx context.Context, params *autoscaling.PutScheduledUpdateGroupActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	m.put = params
+	return &autoscaling.PutScheduledUpdateGroupActionOutput{}, nil
+}
+
+func (m *mockAutoScalingClient) DeleteScheduledAction(ctx context.Context, params *autoscaling.DeleteScheduledActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteScheduledActionOutput, error) {
+	m.deleted = params
+	return &autoscaling.DeleteScheduledActionOutput{}, nil
+}
+
+// mockRDSClient serves a single instance and records the modification made.
+type mockRDSClient struct {
+	rds.RDSClientAPI
+	instance rdssdkTypes.DBInstance
+	modified *rdssdk.ModifyDBInstanceInput
+}
+
+func (m *mockRDSClient) DescribeDBInstances(ctx context.Context, params *rdssdk.DescribeDBInstancesInput, optFns ...func(*rdssdk.Options)) (*rdssdk.DescribeDBInstancesOutput, error) {
+	return &rdssdk.DescribeDBInstancesOutput{DBInstances: []rdssdkTypes.DBInstance{m.instance}}, nil
+}
+
+func (m *mockRDSClient) ModifyDBInstance(ctx context.Context, params *rdssdk.ModifyDBInstanceInput, optFns ...func(*rdssdk.Options)) (*rdssdk.ModifyDBInstanceOutput, error) {
+	m.modified = params
+	return &rdssdk.ModifyDBInstanceOutput{}, nil
+}
+
+// mockSSMClient serves parameters, keyed by name or "name:version", and records the parameters
+// put and deleted.
+type mockSSMClient struct {
+	ssm.SSMClientAPI
+	params  map[string]ssmTypes.Parameter
+	put     *ssmsdk.PutParameterInput
+	deleted []string
+}
+
+func (m *mockSSMClient) GetParameter(ctx context.Context, params *ssmsdk.GetParameterInput, optFns ...func(*ssmsdk.Options)) (*ssmsdk.GetParameterOutput, error) {
+	param, ok := m.params[aws.ToString(params.Name)]
+	if !ok {
+		return nil, &ssmTypes.ParameterNotFound{}
+	}
+	return &ssmsdk.GetParameterOutput{Parameter: &param}, nil
+}
+
+func (m *mockSSMClient) PutParameter(ctx context.Context, params *ssmsdk.PutParameterInput, optFns ...func(*ssmsdk.Options)) (*ssmsdk.PutParameterOutput, error) {
+	m.put = params
+	return &ssmsdk.PutParameterOutput{}, nil
+}
+
+func (m *mockSSMClient) DeleteParameter(ctx context.Context, params *ssmsdk.DeleteParameterInput, optFns ...func(*ssmsdk.Options)) (*ssmsdk.DeleteParameterOutput, error) {
+	m.deleted = append(m.deleted, aws.ToString(params.Name))
+	return &ssmsdk.DeleteParameterOutput{}, nil
+}
+
+// useClients replaces the service constructors with ones returning the given mock clients.
+func useClients(t *testing.T, ec2Client *mockEC2Client, asgClient *mockAutoScalingClient, rdsClient *mockRDSClient, ssmClient *mockSSMClient) {
+	t.Helper()
+	oldEC2, oldASG, oldRDS, oldSSM := newEC2Service, newAutoScalingService, newRDSService, newSSMService
+	t.Cleanup(func() {
+		newEC2Service, newAutoScalingService, newRDSService, newSSMService = oldEC2, oldASG, oldRDS, oldSSM
+	})
+	newEC2Service = func(ctx context.Context, profile, region string) (*ec2.EC2Service, error) {
+		return &ec2.EC2Service{Client: ec2Client}, nil
+	}
+	newAutoScalingService = func(ctx context.Context, profile, region string) (*asg.AutoScalingService, error) {
+		return &asg.AutoScalingService{Client: asgClient}, nil
+	}
+	newRDSService = func(ctx context.Context, profile, region string) (*rds.RDSService, error) {
+		return &rds.RDSService{Client: rdsClient}, nil
+	}
+	newSSMService = func(ctx context.Context, profile, region string) (*ssm.SSMService, error) {
+		return &ssm.SSMService{Client: ssmClient}, nil
+	}
+}
+
+// reverse runs the handler registered for a kind of change against a resource.
+func reverse(t *testing.T, undo, target string, before map[string]string) (*Reversal, error) {
+	t.Helper()
+	handler, err := getHandler(undo)
+	require.NoError(t, err)
+	uri, err := awsutil.ParseResourceURI(target)
+	require.NoError(t, err)
+	return handler(context.Background(), "", "eu-west-1", uri, before)
+}
+
+// Unit test for the ec2/stop and ec2/start handlers
+func TestEC2StateHandlers(t *testing.T) {
+	tests := []struct {
+		name        string
+		undo        string
+		recorded    string
+		current     string
+		wantChanges []cmdutil.Change
+		wantStarted []string
+		wantStopped []string
+		wantUndo    string
+		wantNote    bool
+		wantErr     string
+	}{
+		{
+			name: "stop of a running instance starts it", undo: "ec2/stop", recorded: "running", current: "stopped",
+			wantChanges: []cmdutil.Change{{Resource: "i-0abc", Field: "state", Before: "stopped", After: "running"}},
+			wantStarted: []string{"i-0abc"}, wantUndo: "ec2/start",
+		},
+		{
+			name: "start of a stopped instance stops it", undo: "ec2/start", recorded: "stopped", current: "running",
+			wantChanges: []cmdutil.Change{{Resource: "i-0abc", Field: "state", Before: "running", After: "stopped"}},
+			wantStopped: []string{"i-0abc"}, wantUndo: "ec2/stop",
+		},
+		{name: "stop of a stopped instance had no effect", undo: "ec2/stop", recorded: "stopped", current: "running", wantNote: true},
+		{name: "start of a running instance had no effect", undo: "ec2/start", recorded: "running", current: "stopped", wantNote: true},
+		{
+			name: "stop of a stopping instance", undo: "ec2/stop", recorded: "stopping", current: "stopped",
+			wantErr: "instance i-0abc was stopping before the change, which is not a state it can be returned to",
+		},
+		{
+			name: "start of a pending instance", undo: "ec2/start", recorded: "pending", current: "running",
+			wantErr: "instance i-0abc was pending before the change, which is not a state it can be returned to",
+		},
+		{
+			name: "no recorded state", undo: "ec2/stop", current: "stopped",
+			wantErr: "no state was recorded for instance i-0abc before the change",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mockEC2Client{state: tt.current}
+			useClients(t, client, nil, nil, nil)
+
+			before := map[string]string{}
+			if tt.recorded != "" {
+				before["state"] = tt.recorded
+			}
+			reversal, err := reverse(t, tt.undo, "ec2://instance/i-0abc", before)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantNote {
+				assert.NotEmpty(t, reversal.Note)
+				assert.Nil(t, reversal.Apply)
+				return
+			}
+
+			assert.Equal(t, tt.wantChanges, reversal.Changes)
+			assert.Equal(t, tt.wantUndo, reversal.Undo)
+			assert.Equal(t, map[string]string{"state": tt.current}, reversal.Before)
+			require.NoError(t, reversal.Apply(context.Background()))
+			assert.Equal(t, tt.wantStarted, client.started)
+			assert.Equal(t, tt.wantStopped, client.stopped)
+		})
+	}
+}
+
+// Unit test for the asg/modify handler
+func TestASGCapacityHandler(t *testing.T) {
+	client := &mockAutoScalingClient{group: autoscalingTypes.AutoScalingGroup{
+		AutoScalingGroupName: aws.String("web"),
+		MinSize:              aws.Int32(1),
+		MaxSize:              aws.Int32(10),
+		DesiredCapacity:      aws.Int32(8),
+	}}
+	useClients(t, nil, client, nil, nil)
+
+	reversal, err := reverse(t, "asg/modify", "asg://auto-scaling-group/web", map[string]string{"min": "1", "max": "4", "desired": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, []cmdutil.Change{
+		{Resource: "web", Field: "desired", Before: "8", After: "2"},
+		{Resource: "web", Field: "max", Before: "10", After: "4"},
+	}, reversal.Changes)
+	assert.Equal(t, map[string]string{"min": "1", "max": "10", "desired": "8"}, reversal.Before)
+	assert.Equal(t, "asg/modify", reversal.Undo)
+
+	require.NoError(t, reversal.Apply(context.Background()))
+	assert.Equal(t, "web", aws.ToString(client.updated.AutoScalingGroupName))
+	assert.Equal(t, int32(1), aws.ToInt32(client.updated.MinSize))
+	assert.Equal(t, int32(4), aws.ToInt32(client.updated.MaxSize))
+	assert.Equal(t, int32(2), aws.ToInt32(client.updated.DesiredCapacity))
+
+	_, err = reverse(t, "asg/modify", "asg://auto-scaling-group/web", map[string]string{"min": "one"})
+	assert.Error(t, err)
+}
+
+// Unit test for the asg/schedule handler restoring a removed or changed action, and removing an
+// added one
+func TestASGScheduleHandler(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	recorded := map[string]string{"min": "2", "desired": "4", "recurrence": "0 9 * * 1-5", "start_time": start.Format(time.RFC3339)}
+
+	t.Run("removed action is recreated", func(t *testing.T) {
+		client := &mockAutoScalingClient{}
+		useClients(t, nil, client, nil, nil)
+
+		reversal, err := reverse(t, "asg/schedule", "asg://schedule/web/morning", recorded)
+		require.NoError(t, err)
+		assert.Len(t, reversal.Changes, 4)
+		assert.Empty(t, reversal.Before)
+
+		require.NoError(t, reversal.Apply(context.Background()))
+		require.NotNil(t, client.put)
+		assert.Equal(t, "web", aws.ToString(client.put.AutoScalingGroupName))
+		assert.Equal(t, "morning", aws.ToString(client.put.ScheduledActionName))
+		assert.Equal(t, int32(2), aws.ToInt32(client.put.MinSize))
+		assert.Nil(t, client.put.MaxSize)
+		assert.Equal(t, "0 9 * * 1-5", aws.ToString(client.put.Recurrence))
+		assert.True(t, start.Equal(aws.ToTime(client.put.StartTime)))
+		assert.Nil(t, client.deleted)
+	})
+
+	t.Run("changed action is restored", func(t *testing.T) {
+		client := &mockAutoScalingClient{schedules: []autoscalingTypes.ScheduledUpdateGroupAction{{
+			ScheduledActionName: aws.String("morning"),
+			MinSize:             aws.Int32(2),
+			DesiredCapacity:     aws.Int32(6),
+			Recurrence:          aws.String("0 9 * * 1-5"),
+			StartTime:           aws.Time(start),
+		}}}
+		useClients(t, nil, client, nil, nil)
+
+		reversal, err := reverse(t, "asg/schedule", "asg://schedule/web/morning", recorded)
+		require.NoError(t, err)
+		assert.Equal(t, []cmdutil.Change{{Resource: "morning", Field: "desired", Before: "6", After: "4"}}, reversal.Changes)
+		require.NoError(t, reversal.Apply(context.Background()))
+		assert.Equal(t, int32(4), aws.ToInt32(client.put.DesiredCapacity))
+	})
+
+	t.Run("added action is removed", func(t *testing.T) {
+		client := &mockAutoScalingClient{schedules: []autoscalingTypes.ScheduledUpdateGroupAction{{
+			ScheduledActionName: aws.String("morning"),
+			DesiredCapacity:     aws.Int32(6),
+		}}}
+		useClients(t, nil, client, nil, nil)
+
+		reversal, err := reverse(t, "asg/schedule", "asg://schedule/web/morning", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []cmdutil.Change{{Resource: "morning", Field: "desired", Before: "6"}}, reversal.Changes)
+		require.NoError(t, reversal.Apply(context.Background()))
+		require.NotNil(t, client.deleted)
+		assert.Equal(t, "web", aws.ToString(client.deleted.AutoScalingGroupName))
+		assert.Equal(t, "morning", aws.ToString(client.deleted.ScheduledActionName))
+		assert.Nil(t, client.put)
+	})
+}
+
+// Unit test for the rds/modify handler
+func TestRDSModifyHandler(t *testing.T) {
+	client := &mockRDSClient{instance: rdssdkTypes.DBInstance{
+		DBInstanceIdentifier:       aws.String("orders"),
+		DBInstanceClass:            aws.String("db.r6g.large"),
+		PreferredMaintenanceWindow: aws.String("sun:03:00-sun:04:00"),
+	}}
+	useClients(t, nil, nil, client, nil)
+
+	t.Run("instance class applied immediately", func(t *testing.T) {
+		reversal, err := reverse(t, "rds/modify", "rds://instance/orders", map[string]string{
+			"instance_class":    "db.t4g.medium",
+			"apply_immediately": "true",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []cmdutil.Change{{Resource: "orders", Field: "instance_class", Before: "db.r6g.large", After: "db.t4g.medium"}}, reversal.Changes)
+		assert.Equal(t, map[string]string{"instance_class": "db.r6g.large", "apply_immediately": "true"}, reversal.Before)
+
+		require.NoError(t, reversal.Apply(context.Background()))
+		assert.Equal(t, "db.t4g.medium", aws.ToString(client.modified.DBInstanceClass))
+		assert.Nil(t, client.modified.PreferredMaintenanceWindow)
+		assert.True(t, aws.ToBool(client.modified.ApplyImmediately))
+	})
+
+	t.Run("maintenance window at the next window", func(t *testing.T) {
+		reversal, err := reverse(t, "rds/modify", "rds://instance/orders", map[string]string{
+			"maintenance_window": "mon:01:00-mon:02:00",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []cmdutil.Change{
+			{Resource: "orders", Field: "maintenance_window", Before: "sun:03:00-sun:04:00", After: "mon:01:00-mon:02:00"},
+		}, reversal.Changes)
+
+		require.NoError(t, reversal.Apply(context.Background()))
+		assert.Equal(t, "mon:01:00-mon:02:00", aws.ToString(client.modified.PreferredMaintenanceWindow))
+		assert.Nil(t, client.modified.DBInstanceClass)
+		assert.Nil(t, client.modified.ApplyImmediately)
+	})
+}
+
+// Unit test for the ssm/parameter handler restoring a previous version, and deleting a
+// parameter that did not exist before the change
+func TestSSMParameterHandler(t *testing.T) {
+	current := ssmTypes.Parameter{Name: aws.String("/app/db"), Value: aws.String("new"), Type: ssmTypes.ParameterTypeSecureString, Version: 3}
+	previous := ssmTypes.Parameter{Name: aws.String("/app/db"), Value: aws.String("old"), Type: ssmTypes.ParameterTypeSecureString, Version: 2}
+
+	t.Run("previous version is restored", func(t *testing.T) {
+		client := &mockSSMClient{params: map[string]ssmTypes.Parameter{"/app/db": current, "/app/db:2": previous}}
+		useClients(t, nil, nil, nil, client)
+
+		reversal, err := reverse(t, "ssm/parameter", "ssm://parameter//app/db", map[string]string{"version": "2", "type": "SecureString"})
+		require.NoError(t, err)
+		assert.Equal(t, []cmdutil.Change{{Resource: "/app/db", Field: "Value", Before: "version 3", After: "value of version 2"}}, reversal.Changes)
+		assert.Equal(t, map[string]string{"version": "3", "type": "SecureString"}, reversal.Before)
+
+		require.NoError(t, reversal.Apply(context.Background()))
+		require.NotNil(t, client.put)
+		assert.Equal(t, "/app/db", aws.ToString(client.put.Name))
+		assert.Equal(t, "old", aws.ToString(client.put.Value))
+		assert.Equal(t, ssmTypes.ParameterTypeSecureString, client.put.Type)
+		assert.True(t, aws.ToBool(client.put.Overwrite))
+	})
+
+	t.Run("created parameter is deleted", func(t *testing.T) {
+		client := &mockSSMClient{params: map[string]ssmTypes.Parameter{"/app/db": current}}
+		useClients(t, nil, nil, nil, client)
+
+		reversal, err := reverse(t, "ssm/parameter", "ssm://parameter//app/db", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []cmdutil.Change{{Resource: "/app/db", Field: "Value", Before: "version 3"}}, reversal.Changes)
+
+		require.NoError(t, reversal.Apply(context.Background()))
+		assert.Equal(t, []string{"/app/db"}, client.deleted)
+		assert.Nil(t, client.put)
+	})
+
+	t.Run("deleted parameter cannot be restored", func(t *testing.T) {
+		client := &mockSSMClient{params: map[string]ssmTypes.Parameter{}}
+		useClients(t, nil, nil, nil, client)
+
+		_, err := reverse(t, "ssm/parameter", "ssm://parameter//app/db", map[string]string{"version": "2"})
+		assert.EqualError(t, err, "parameter /app/db has been deleted, so version 2 cannot be restored")
+	})
+}
@@ -0,0 +1,71 @@
+package undo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+)
+
+// Reversal restores a resource to the state recorded in a journal entry.
+type Reversal struct {
+	// Changes are the changes the reversal makes, from the current state of the resource.
+	Changes []cmdutil.Change
+	// Apply makes the changes.
+	Apply func(ctx context.Context) error
+	// Undo names the handler that can reverse the reversal, and Before is the current state it
+	// replaces, for recording in the journal.
+	Undo   string
+	Before map[string]string
+	// Note explains why there is nothing to undo, if the change had no effect.
+	Note string
+}
+
+// UndoHandler returns the Reversal that restores a resource to the state it was in before a
+// change, from the state recorded in the journal.
+type UndoHandler func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI, before map[string]string) (*Reversal, error)
+
+var handlers = map[string]UndoHandler{}
+
+// RegisterHandler registers an undo handler for a kind of change.
+// key format: "service/change" (e.g. "ec2/stop", "asg/modify").
+func RegisterHandler(key string, handler UndoHandler) {
+	handlers[key] = handler
+}
+
+// getHandler returns the registered handler for a kind of change, or an error if none exists.
+func getHandler(key string) (UndoHandler, error) {
+	handler, ok := handlers[key]
+	if !ok {
+		return nil, fmt.Errorf("undo is not supported for %s", key)
+	}
+	return handler, nil
+}
+
+// stateChanges returns the changes between the current state of a resource and the state it is
+// restored to, for the keys whose values differ.
+func stateChanges(resource string, current, restored map[string]string) []cmdutil.Change {
+	keys := slices.Collect(maps.Keys(current))
+	for key := range restored {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []cmdutil.Change
+	for _, key := range keys {
+		if current[key] != restored[key] {
+			changes = append(changes, cmdutil.Change{
+				Resource: resource,
+				Field:    key,
+				Before:   current[key],
+				After:    restored[key],
+			})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,143 @@
+package undo
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/journal"
+	"github.com/spf13/cobra"
+)
+
+var yes bool
+
+// NewUndoCmd creates the undo command.
+func NewUndoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undo [id]",
+		Short: "Reverse a change recorded in the history",
+		Long: `Reverse a change made by asc, restoring the resource to the state recorded in the
+journal before the change. Without an ID, the most recent change that can be undone is
+reversed. Use 'asc history' to list changes and their IDs.
+
+The change is reversed in the profile and region it was made in. A change made with
+--role-arn, --external-id or --endpoint-url must be undone with the same flags, and undo
+refuses to run otherwise. Undoing a change is itself recorded, and can be undone in turn.
+
+Changes that can be undone:
+  asg modify                      Restores the min, max and desired capacity
+  asg schedule add/rm             Restores or removes the scheduled action
+  ec2 start/stop                  Returns the instance to its state before the change
+  rds modify                      Restores the instance class and maintenance window
+  ssm set/edit/cp/revert/import   Restores the previous version of the parameter,
+                                  or deletes it if it did not exist`,
+		Example: `  asc undo              # Undo the most recent change
+  asc undo 42           # Undo change 42
+  asc undo 42 --dry-run # Show what undoing change 42 would do
+  asc undo 42 --yes     # Undo without confirmation`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(runUndo(cmd, args))
+		},
+	}
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Undo without confirmation")
+	cmdutil.AllowDryRun(cmd)
+	return cmd
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	entries, err := journal.Load()
+	if err != nil {
+		return err
+	}
+	entry, err := findEntry(entries, args)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.CheckEntryScope(cmd, entry); err != nil {
+		return err
+	}
+
+	uri, err := awsutil.ParseResourceURI(entry.Target)
+	if err != nil {
+		return fmt.Errorf("change %d: %w", entry.ID, err)
+	}
+	handler, err := getHandler(entry.Undo)
+	if err != nil {
+		return err
+	}
+	reversal, err := handler(ctx, entry.Profile, entry.Region, uri, entry.Before)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Change %d: %s (%s)\n", entry.ID, entry.Command, entry.Time.Local().Format("2006-01-02 15:04:05 MST"))
+	if reversal.Note != "" {
+		fmt.Println(reversal.Note)
+		return nil
+	}
+	if len(reversal.Changes) == 0 {
+		fmt.Printf("%s is already in the state it was in before the change.\n", entry.Target)
+		return nil
+	}
+	if cmdutil.IsDryRun(cmd) {
+		cmdutil.RenderPlan(reversal.Changes)
+		return nil
+	}
+
+	cmdutil.RenderChanges(fmt.Sprintf("Undo change %d", entry.ID), reversal.Changes)
+	if !yes {
+		fmt.Print("Undo this change? [y/N]: ")
+		reader := bufio.NewReader(os.Stdin)
+		response, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("read confirmation: %w", err)
+		}
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	if err := reversal.Apply(ctx); err != nil {
+		return fmt.Errorf("undo change %d: %w", entry.ID, err)
+	}
+	cmdutil.RecordUndo(cmd, entry, reversal.Undo, reversal.Before)
+	fmt.Printf("Undid change %d\n", entry.ID)
+	return nil
+}
+
+// findEntry returns the entry with the ID given as an argument, or the most recent entry that
+// can be undone, and checks that it can be.
+func findEntry(entries []journal.Entry, args []string) (journal.Entry, error) {
+	if len(args) == 0 {
+		entry, ok := journal.LastUndoable(entries)
+		if !ok {
+			return entry, fmt.Errorf("no changes to undo")
+		}
+		return entry, nil
+	}
+
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return journal.Entry{}, fmt.Errorf("invalid change ID %q", args[0])
+	}
+	entry, ok := journal.Find(entries, id)
+	if !ok {
+		return entry, fmt.Errorf("change %d not found in history", id)
+	}
+	if entry.Undo == "" {
+		return entry, fmt.Errorf("change %d (%s) cannot be undone", id, entry.Command)
+	}
+	if by, ok := journal.UndoneBy(entries)[id]; ok {
+		return entry, fmt.Errorf("change %d has already been undone by change %d", id, by)
+	}
+	return entry, nil
+}
@@ -0,0 +1,66 @@
+package undo
+
+import (
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Unit test for findEntry
+func TestFindEntry(t *testing.T) {
+	entries := []journal.Entry{
+		{ID: 1, Command: "asc ec2 stop i-0abc", Undo: "ec2/stop"},
+		{ID: 2, Command: "asc ec2 terminate i-0def"},
+		{ID: 3, Command: "asc asg modify web --desired 4", Undo: "asg/modify"},
+		{ID: 4, Command: "asc undo 3", Undo: "asg/modify", UndoOf: 3},
+	}
+
+	tests := []struct {
+		name    string
+		entries []journal.Entry
+		args    []string
+		wantID  int
+		wantErr string
+	}{
+		{name: "most recent undoable change", entries: entries, wantID: 1},
+		{name: "most recent change", entries: entries[:3], wantID: 3},
+		{name: "by ID", entries: entries, args: []string{"1"}, wantID: 1},
+		{name: "nothing to undo", entries: entries[1:2], wantErr: "no changes to undo"},
+		{name: "invalid ID", entries: entries, args: []string{"abc"}, wantErr: `invalid change ID "abc"`},
+		{name: "unknown ID", entries: entries, args: []string{"9"}, wantErr: "change 9 not found in history"},
+		{name: "cannot be undone", entries: entries, args: []string{"2"}, wantErr: "change 2 (asc ec2 terminate i-0def) cannot be undone"},
+		{name: "already undone", entries: entries, args: []string{"3"}, wantErr: "change 3 has already been undone by change 4"},
+		{name: "an undo can be undone", entries: entries, args: []string{"4"}, wantID: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := findEntry(tt.entries, tt.args)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, entry.ID)
+		})
+	}
+}
+
+// Unit test for stateChanges
+func TestStateChanges(t *testing.T) {
+	changes := stateChanges("web",
+		map[string]string{"min": "1", "max": "4", "desired": "4"},
+		map[string]string{"min": "1", "max": "6", "desired": "2", "recurrence": "0 9 * * *"},
+	)
+	assert.Equal(t, []cmdutil.Change{
+		{Resource: "web", Field: "desired", Before: "4", After: "2"},
+		{Resource: "web", Field: "max", Before: "4", After: "6"},
+		{Resource: "web", Field: "recurrence", After: "0 9 * * *"},
+	}, changes)
+
+	assert.Empty(t, stateChanges("web", map[string]string{"min": "1"}, map[string]string{"min": "1"}))
+	assert.Equal(t, []cmdutil.Change{{Resource: "web", Field: "min", Before: "1"}},
+		stateChanges("web", map[string]string{"min": "1"}, map[string]string{}))
+}
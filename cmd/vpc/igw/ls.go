@@ -2,6 +2,7 @@
 package igw
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/vpc"
@@ -15,8 +16,6 @@ import (
 // Variables
 var (
 	list        bool
-	sortId      bool
-	sortState   bool
 	reverseSort bool
 )
 
@@ -28,8 +27,8 @@ func init() {
 // Define columns for Internet Gateways
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Internet Gateway ID", Category: "IGW", Visible: true, DefaultSort: true, SortBy: sortId, SortDirection: tablewriter.Asc},
-		{Name: "State", Category: "IGW", Visible: true, SortBy: sortState, SortDirection: tablewriter.Asc},
+		{Name: "Internet Gateway ID", Category: "IGW", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
+		{Name: "State", Category: "IGW", Visible: true},
 		{Name: "VPC ID", Category: "IGW", Visible: true},
 		{Name: "Owner", Category: "IGW", Visible: true},
 	}
@@ -48,32 +47,39 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs internet gateways in list format.")
-	cobraCmd.Flags().BoolVarP(&sortId, "sort-id", "i", false, "Sort by descending internet gateway ID.")
-	cobraCmd.Flags().BoolVarP(&sortState, "sort-state", "S", false, "Sort by descending state.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Internet Gateway ID")
+	cmdutil.AddSortAlias(cobraCmd, "sort-state", "S", "State")
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
 }
 
 // ListIGWs is the handler for the ls subcommand.
 func ListIGWs(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
-	if err != nil {
-		return fmt.Errorf("create new VPC service: %w", err)
-	}
-
-	igws, err := svc.GetIGWs(cmd.Context(), &ascTypes.GetIGWsInput{})
+	results, err := cmdutil.FanOut(cmd, vpc.NewVPCService, func(ctx context.Context, svc *vpc.VPCService) ([]any, error) {
+		igws, err := svc.GetIGWs(ctx, &ascTypes.GetIGWsInput{})
+		if err != nil {
+			return nil, fmt.Errorf("get internet gateways: %w", err)
+		}
+		return utils.SlicesToAny(igws), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get internet gateways: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Internet Gateways",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(igws),
-		GetFieldValue: vpc.GetIGWFieldValue,
-		GetTagValue:   vpc.GetIGWTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(vpc.GetIGWFieldValue),
+		GetTagValue:   results.TagGetter(vpc.GetIGWTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
@@ -3,6 +3,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/vpc"
@@ -15,16 +16,10 @@ import (
 
 // Variables
 var (
-	list         bool
-	sortId       bool
-	reverseSort  bool
-	sortName     bool
-	sortState    bool
-	sortIPv4CIDR bool
-	sortIPv6CIDR bool
-	sortOwnerID  bool
-	showDHCP     bool
-	showTenancy  bool
+	list        bool
+	reverseSort bool
+	showDHCP    bool
+	showTenancy bool
 )
 
 // Init function
@@ -35,12 +30,12 @@ func init() {
 // Column functions
 func getVPCListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "VPC ID", Category: "VPC", Visible: true, DefaultSort: true, SortBy: sortId, SortDirection: tablewriter.Asc},
-		{Name: "State", Category: "VPC", Visible: true, SortBy: sortState, SortDirection: tablewriter.Asc},
+		{Name: "VPC ID", Category: "VPC", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
+		{Name: "State", Category: "VPC", Visible: true},
 		{Name: "Tenancy", Category: "VPC", Visible: showTenancy},
 		{Name: "DHCP Option Set", Category: "VPC", Visible: showDHCP},
-		{Name: "IPv4 CIDR", Category: "VPC", Visible: true, SortBy: sortIPv4CIDR, SortDirection: tablewriter.Asc},
-		{Name: "IPv6 CIDR", Category: "VPC", Visible: true, SortBy: sortIPv6CIDR, SortDirection: tablewriter.Asc},
+		{Name: "IPv4 CIDR", Category: "VPC", Visible: true},
+		{Name: "IPv6 CIDR", Category: "VPC", Visible: true},
 		{Name: "Default VPC", Category: "VPC", Visible: true},
 		{Name: "Owner ID", Category: "VPC", Visible: true},
 	}
@@ -71,13 +66,16 @@ var lsCmd = &cobra.Command{
 // Flag function
 func newLsFlags(lsCmd *cobra.Command) {
 	lsCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs VPCs in list format.")
+	cmdutil.AddColumnsFlag(lsCmd, getVPCListFields, getSubnetListFields)
+	cmdutil.AddSortFlag(lsCmd, getVPCListFields, getSubnetListFields)
+	cmdutil.AddFilterFlag(lsCmd, getVPCListFields, getSubnetListFields)
+	cmdutil.AddTargetFlags(lsCmd)
 	lsCmd.Flags().BoolVarP(&reverseSort, "reverse-sort", "r", false, "Reverse the sort order.")
-	lsCmd.Flags().BoolVarP(&sortName, "sort-name", "n", false, "Sort by descending VPC name.")
-	lsCmd.Flags().BoolVarP(&sortId, "sort-id", "i", false, "Sort by descending VPC ID.")
-	lsCmd.Flags().BoolVarP(&sortState, "sort-state", "s", false, "Sort by descending VPC state.")
-	lsCmd.Flags().BoolVarP(&sortIPv4CIDR, "sort-ipv4-cidr", "I", false, "Sort by descending VPC IPv4 CIDR.")
-	lsCmd.Flags().BoolVarP(&sortIPv6CIDR, "sort-ipv6-cidr", "6", false, "Sort by descending VPC IPv6 CIDR.")
-	lsCmd.Flags().BoolVarP(&sortOwnerID, "sort-owner-id", "o", false, "Sort by descending VPC owner ID.")
+	cmdutil.AddSortAlias(lsCmd, "sort-id", "i", "VPC ID")
+	cmdutil.AddSortAlias(lsCmd, "sort-state", "s", "State")
+	cmdutil.AddSortAlias(lsCmd, "sort-ipv4-cidr", "I", "IPv4 CIDR")
+	cmdutil.AddSortAlias(lsCmd, "sort-ipv6-cidr", "6", "IPv6 CIDR")
+	cmdutil.AddSortAlias(lsCmd, "sort-owner-id", "o", "Owner ID")
 	lsCmd.Flags().BoolVarP(&showDHCP, "show-dhcp", "d", false, "Show the DHCP option set for the VPC.")
 	lsCmd.Flags().BoolVarP(&showTenancy, "show-tenancy", "T", false, "Show the tenancy for the VPC.")
 	lsCmd.MarkFlagsMutuallyExclusive()
@@ -85,31 +83,34 @@ func newLsFlags(lsCmd *cobra.Command) {
 
 // List function
 func ListVPCs(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
-	if err != nil {
-		return fmt.Errorf("create new VPC service: %w", err)
-	}
-
 	if len(args) > 0 {
 		return ListVPCSubnets(cmd, args)
-	} else {
-		vpcList, err := svc.GetVPCs(cmd.Context(), &ascTypes.GetVPCsInput{})
+	}
+
+	results, err := cmdutil.FanOut(cmd, vpc.NewVPCService, func(ctx context.Context, svc *vpc.VPCService) ([]any, error) {
+		vpcList, err := svc.GetVPCs(ctx, &ascTypes.GetVPCsInput{})
 		if err != nil {
-			return fmt.Errorf("list VPCs: %w", err)
+			return nil, fmt.Errorf("list VPCs: %w", err)
 		}
-
-		tablewriter.RenderList(tablewriter.RenderListOptions{
-			Title:         "VPCs",
-			PlainStyle:    list,
-			Fields:        getVPCListFields(),
-			Tags:          cmdutil.Tags,
-			Data:          utils.SlicesToAny(vpcList),
-			GetFieldValue: vpc.GetFieldValue,
-			GetTagValue:   vpc.GetTagValue,
-			ReverseSort:   reverseSort,
-		})
-		return nil
+		return utils.SlicesToAny(vpcList), nil
+	})
+	if err != nil {
+		return err
 	}
+
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
+		Title:         "VPCs",
+		PlainStyle:    list,
+		Fields:        results.Fields(getVPCListFields()),
+		Tags:          cmdutil.Tags,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(vpc.GetFieldValue),
+		GetTagValue:   results.TagGetter(vpc.GetTagValue),
+		ReverseSort:   reverseSort,
+	})
 }
 
 func ListVPCSubnets(cmd *cobra.Command, args []string) error {
@@ -123,15 +124,17 @@ func ListVPCSubnets(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("list subnets: %w", err)
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         fmt.Sprintf("%s - Subnets", args[0]),
 		PlainStyle:    list,
 		Fields:        getSubnetListFields(),
 		Tags:          cmdutil.Tags,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(subnets),
 		GetFieldValue: vpc.GetSubnetFieldValue,
 		GetTagValue:   vpc.GetSubnetTagValue,
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
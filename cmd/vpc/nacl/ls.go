@@ -1,6 +1,7 @@
 package nacl
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/vpc"
@@ -14,7 +15,6 @@ import (
 var (
 	list        bool
 	reverseSort bool
-	sortId      bool
 )
 
 func init() {
@@ -24,7 +24,7 @@ func init() {
 // getListFields returns the fields for the NACL list table.
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Network ACL ID", Category: "NACL", Visible: true, DefaultSort: true, SortBy: sortId, SortDirection: tablewriter.Asc},
+		{Name: "Network ACL ID", Category: "NACL", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
 		{Name: "Associated with", Category: "NACL", Visible: true},
 		{Name: "Default", Category: "NACL", Visible: true},
 		{Name: "VPC ID", Category: "NACL", Visible: true},
@@ -58,37 +58,44 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs NACLs in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields, getRulesFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields, getRulesFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields, getRulesFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
-	cobraCmd.Flags().BoolVarP(&sortId, "sort-id", "i", false, "Sort by descending network ACL ID.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Network ACL ID")
 }
 
 // ListNACLs is the handler for the ls subcommand.
 func ListNACLs(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
-	if err != nil {
-		return fmt.Errorf("create new VPC service: %w", err)
-	}
-
 	if len(args) > 0 {
 		return ListNACLRules(cmd, args)
-	} else {
-		nacls, err := svc.GetNACLs(cmd.Context(), &ascTypes.GetNACLsInput{})
+	}
+
+	results, err := cmdutil.FanOut(cmd, vpc.NewVPCService, func(ctx context.Context, svc *vpc.VPCService) ([]any, error) {
+		nacls, err := svc.GetNACLs(ctx, &ascTypes.GetNACLsInput{})
 		if err != nil {
-			return fmt.Errorf("get network acls: %w", err)
+			return nil, fmt.Errorf("get network acls: %w", err)
 		}
-
-		tablewriter.RenderList(tablewriter.RenderListOptions{
-			Title:         "Network ACLs",
-			PlainStyle:    list,
-			Fields:        getListFields(),
-			Tags:          cmdutil.Tags,
-			Data:          utils.SlicesToAny(nacls),
-			GetFieldValue: vpc.GetFieldValue,
-			GetTagValue:   vpc.GetTagValue,
-			ReverseSort:   reverseSort,
-		})
-		return nil
+		return utils.SlicesToAny(nacls), nil
+	})
+	if err != nil {
+		return err
 	}
+
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
+		Title:         "Network ACLs",
+		PlainStyle:    list,
+		Fields:        results.Fields(getListFields()),
+		Tags:          cmdutil.Tags,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(vpc.GetFieldValue),
+		GetTagValue:   results.TagGetter(vpc.GetTagValue),
+		ReverseSort:   reverseSort,
+	})
 }
 
 // ListNACLRules is the handler for the ls subcommand.
@@ -112,25 +119,32 @@ func ListNACLRules(cmd *cobra.Command, args []string) error {
 	fields := getRulesFields()
 
 	// Print inbound rules table
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	if err := tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         fmt.Sprintf("%s - Inbound Rules", args[0]),
 		PlainStyle:    list,
 		Fields:        fields,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(ingressRules),
 		GetFieldValue: vpc.GetFieldValue,
 		GetTagValue:   vpc.GetTagValue,
 		ReverseSort:   reverseSort,
-	})
+	}); err != nil {
+		return err
+	}
 
 	// Print outbound rules table
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         fmt.Sprintf("%s - Outbound Rules", args[0]),
 		PlainStyle:    list,
 		Fields:        fields,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(egressRules),
 		GetFieldValue: vpc.GetFieldValue,
 		GetTagValue:   vpc.GetTagValue,
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
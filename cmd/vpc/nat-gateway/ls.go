@@ -1,6 +1,7 @@
 package nat_gateway
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/vpc"
@@ -47,30 +48,37 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs NAT Gateways in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, natGatewayListFields)
+	cmdutil.AddSortFlag(cobraCmd, natGatewayListFields)
+	cmdutil.AddFilterFlag(cobraCmd, natGatewayListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
 }
 
 // ListNatGateways is the handler for the ls subcommand.
 func ListNatGateways(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
-	if err != nil {
-		return fmt.Errorf("create service: %w", err)
-	}
-
-	nats, err := svc.GetNatGateways(cmd.Context(), &ascTypes.GetNatGatewaysInput{})
+	results, err := cmdutil.FanOut(cmd, vpc.NewVPCService, func(ctx context.Context, svc *vpc.VPCService) ([]any, error) {
+		nats, err := svc.GetNatGateways(ctx, &ascTypes.GetNatGatewaysInput{})
+		if err != nil {
+			return nil, fmt.Errorf("get nat gateways: %w", err)
+		}
+		return utils.SlicesToAny(nats), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get nat gateways: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "NAT Gateways",
 		PlainStyle:    list,
-		Fields:        natGatewayListFields(),
+		Fields:        results.Fields(natGatewayListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(nats),
-		GetFieldValue: vpc.GetFieldValue,
-		GetTagValue:   vpc.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(vpc.GetFieldValue),
+		GetTagValue:   results.TagGetter(vpc.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
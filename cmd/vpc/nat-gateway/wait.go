@@ -7,6 +7,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+func init() {
+	wait.AddFlags(waitCmd)
+}
+
 var waitCmd = &cobra.Command{
 	Use:     "wait <nat-gateway-id>",
 	Short:   "Wait for a NAT gateway to reach a stable state",
@@ -1,6 +1,7 @@
 package prefix_list
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/vpc"
@@ -14,7 +15,6 @@ import (
 var (
 	list        bool
 	reverseSort bool
-	sortId      bool
 )
 
 func init() {
@@ -24,7 +24,7 @@ func init() {
 // getListFields returns the fields for the Prefix List list table.
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Prefix List ID", Category: "Details", Visible: true, DefaultSort: true, SortBy: sortId, SortDirection: tablewriter.Asc},
+		{Name: "Prefix List ID", Category: "Details", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
 		{Name: "Prefix List Name", Category: "Details", Visible: true},
 		{Name: "Max Entries", Category: "Details", Visible: false},
 		{Name: "Address Family", Category: "Details", Visible: true},
@@ -55,37 +55,44 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs Prefix Lists in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields, prefixListEntriesFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields, prefixListEntriesFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields, prefixListEntriesFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
-	cobraCmd.Flags().BoolVarP(&sortId, "sort-id", "i", false, "Sort by descending prefix list ID.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Prefix List ID")
 }
 
 // ListPrefixLists is the handler for the ls subcommand.
 func ListPrefixLists(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
-	if err != nil {
-		return fmt.Errorf("create vpc service: %w", err)
-	}
-
 	if len(args) > 0 {
 		return ListPrefixListEntries(cmd, args)
 	}
 
-	pls, err := svc.GetManagedPrefixLists(cmd.Context(), &ascTypes.GetManagedPrefixListsInput{})
+	results, err := cmdutil.FanOut(cmd, vpc.NewVPCService, func(ctx context.Context, svc *vpc.VPCService) ([]any, error) {
+		pls, err := svc.GetManagedPrefixLists(ctx, &ascTypes.GetManagedPrefixListsInput{})
+		if err != nil {
+			return nil, fmt.Errorf("get prefix lists: %w", err)
+		}
+		return utils.SlicesToAny(pls), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get prefix lists: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Prefix Lists",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(pls),
-		GetFieldValue: vpc.GetFieldValue,
-		GetTagValue:   vpc.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(vpc.GetFieldValue),
+		GetTagValue:   results.TagGetter(vpc.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
 
 func ListPrefixListEntries(cmd *cobra.Command, args []string) error {
@@ -101,12 +108,14 @@ func ListPrefixListEntries(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("get prefix list: %w", err)
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         fmt.Sprintf("%s - Entries", args[0]),
 		Fields:        prefixListEntriesFields(),
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(pl),
 		GetFieldValue: vpc.GetFieldValue,
 		GetTagValue:   vpc.GetTagValue,
 	})
-	return nil
 }
@@ -1,6 +1,7 @@
 package route_table
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/vpc"
@@ -14,7 +15,6 @@ import (
 var (
 	list        bool
 	reverseSort bool
-	sortId      bool
 )
 
 func init() {
@@ -24,7 +24,7 @@ func init() {
 // routeTableListFields returns the fields for the Route Table list table.
 func routeTableListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Route Table ID", Category: "VPC", Visible: true, DefaultSort: true, SortBy: sortId, SortDirection: tablewriter.Asc},
+		{Name: "Route Table ID", Category: "VPC", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
 		{Name: "Association Count", Category: "VPC", Visible: true},
 		{Name: "Route Count", Category: "VPC", Visible: false},
 		{Name: "Main", Category: "VPC", Visible: true},
@@ -56,37 +56,44 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs Route Tables in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, routeTableListFields, routeTableRouteFields)
+	cmdutil.AddSortFlag(cobraCmd, routeTableListFields, routeTableRouteFields)
+	cmdutil.AddFilterFlag(cobraCmd, routeTableListFields, routeTableRouteFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
-	cobraCmd.Flags().BoolVarP(&sortId, "sort-id", "i", false, "Sort by descending route table ID.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Route Table ID")
 }
 
 // ListRouteTables is the handler for the ls subcommand.
 func ListRouteTables(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
-	if err != nil {
-		return fmt.Errorf("create service: %w", err)
-	}
-
 	if len(args) > 0 {
 		return ListRouteTableRules(cmd, args)
-	} else {
-		rts, err := svc.GetRouteTables(cmd.Context(), &ascTypes.GetRouteTablesInput{})
+	}
+
+	results, err := cmdutil.FanOut(cmd, vpc.NewVPCService, func(ctx context.Context, svc *vpc.VPCService) ([]any, error) {
+		rts, err := svc.GetRouteTables(ctx, &ascTypes.GetRouteTablesInput{})
 		if err != nil {
-			return fmt.Errorf("get route tables: %w", err)
+			return nil, fmt.Errorf("get route tables: %w", err)
 		}
-
-		tablewriter.RenderList(tablewriter.RenderListOptions{
-			Title:         "Route Tables",
-			PlainStyle:    list,
-			Fields:        routeTableListFields(),
-			Tags:          cmdutil.Tags,
-			Data:          utils.SlicesToAny(rts),
-			GetFieldValue: vpc.GetFieldValue,
-			GetTagValue:   vpc.GetTagValue,
-			ReverseSort:   reverseSort,
-		})
-		return nil
+		return utils.SlicesToAny(rts), nil
+	})
+	if err != nil {
+		return err
 	}
+
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
+		Title:         "Route Tables",
+		PlainStyle:    list,
+		Fields:        results.Fields(routeTableListFields()),
+		Tags:          cmdutil.Tags,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(vpc.GetFieldValue),
+		GetTagValue:   results.TagGetter(vpc.GetTagValue),
+		ReverseSort:   reverseSort,
+	})
 }
 
 func ListRouteTableRules(cmd *cobra.Command, args []string) error {
@@ -104,15 +111,17 @@ func ListRouteTableRules(cmd *cobra.Command, args []string) error {
 
 	routes := rts[0].Routes
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         fmt.Sprintf("%s - Routes", args[0]),
 		PlainStyle:    list,
 		Fields:        routeTableRouteFields(),
 		Tags:          cmdutil.Tags,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
 		Data:          utils.SlicesToAny(routes),
 		GetFieldValue: vpc.GetFieldValue,
 		GetTagValue:   vpc.GetTagValue,
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
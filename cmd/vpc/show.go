@@ -28,7 +28,7 @@ var showCmd = &cobra.Command{
 	GroupID: "actions",
 	Args:    cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return cmdutil.DefaultErrorHandler(showVPC(cmd, args[0]))
+		return cmdutil.DefaultErrorHandler(ShowVPC(cmd, args[0]))
 	},
 }
 
@@ -53,7 +53,7 @@ func getShowFields() []tablewriter.Field {
 }
 
 // ShowVPC displays detailed information for a specified VPC.
-func showVPC(cmd *cobra.Command, id string) error {
+func ShowVPC(cmd *cobra.Command, id string) error {
 	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
 	if err != nil {
 		return fmt.Errorf("create vpc service: %w", err)
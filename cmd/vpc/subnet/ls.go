@@ -1,6 +1,7 @@
 package subnet
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/harleymckenzie/asc/internal/service/vpc"
@@ -14,7 +15,6 @@ import (
 var (
 	list        bool
 	reverseSort bool
-	sortId      bool
 )
 
 func init() {
@@ -24,7 +24,7 @@ func init() {
 // getListFields returns the fields for the Subnet list table.
 func getListFields() []tablewriter.Field {
 	return []tablewriter.Field{
-		{Name: "Subnet ID", Category: "Subnet", Visible: true, DefaultSort: true, SortBy: sortId, SortDirection: tablewriter.Asc},
+		{Name: "Subnet ID", Category: "Subnet", Visible: true, DefaultSort: true, SortDirection: tablewriter.Asc},
 		{Name: "VPC ID", Category: "Subnet", Visible: true},
 		{Name: "CIDR Block", Category: "Subnet", Visible: true},
 		{Name: "Availability Zone", Category: "Subnet", Visible: true},
@@ -47,31 +47,38 @@ var lsCmd = &cobra.Command{
 // NewLsFlags adds flags for the ls subcommand.
 func NewLsFlags(cobraCmd *cobra.Command) {
 	cobraCmd.Flags().BoolVarP(&list, "list", "l", false, "Outputs Subnets in list format.")
+	cmdutil.AddColumnsFlag(cobraCmd, getListFields)
+	cmdutil.AddSortFlag(cobraCmd, getListFields)
+	cmdutil.AddFilterFlag(cobraCmd, getListFields)
+	cmdutil.AddTargetFlags(cobraCmd)
 	cobraCmd.Flags().BoolVarP(&reverseSort, "reverse", "r", false, "Reverse the sort order")
-	cobraCmd.Flags().BoolVarP(&sortId, "sort-id", "i", false, "Sort by descending subnet ID.")
+	cmdutil.AddSortAlias(cobraCmd, "sort-id", "i", "Subnet ID")
 }
 
 // ListSubnets is the handler for the ls subcommand.
 func ListSubnets(cmd *cobra.Command, args []string) error {
-	svc, err := cmdutil.CreateService(cmd, vpc.NewVPCService)
-	if err != nil {
-		return fmt.Errorf("create service: %w", err)
-	}
-
-	subnets, err := svc.GetSubnets(cmd.Context(), &ascTypes.GetSubnetsInput{})
+	results, err := cmdutil.FanOut(cmd, vpc.NewVPCService, func(ctx context.Context, svc *vpc.VPCService) ([]any, error) {
+		subnets, err := svc.GetSubnets(ctx, &ascTypes.GetSubnetsInput{})
+		if err != nil {
+			return nil, fmt.Errorf("get subnets: %w", err)
+		}
+		return utils.SlicesToAny(subnets), nil
+	})
 	if err != nil {
-		return fmt.Errorf("get subnets: %w", err)
+		return err
 	}
 
-	tablewriter.RenderList(tablewriter.RenderListOptions{
+	return tablewriter.RenderList(tablewriter.RenderListOptions{
 		Title:         "Subnets",
 		PlainStyle:    list,
-		Fields:        getListFields(),
+		Fields:        results.Fields(getListFields()),
 		Tags:          cmdutil.Tags,
-		Data:          utils.SlicesToAny(subnets),
-		GetFieldValue: vpc.GetFieldValue,
-		GetTagValue:   vpc.GetTagValue,
+		Columns:       cmdutil.Columns,
+		Sort:          cmdutil.Sort,
+		Filters:       cmdutil.Filters,
+		Data:          results.Data(),
+		GetFieldValue: results.FieldGetter(vpc.GetFieldValue),
+		GetTagValue:   results.TagGetter(vpc.GetTagValue),
 		ReverseSort:   reverseSort,
 	})
-	return nil
 }
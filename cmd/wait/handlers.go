@@ -3,6 +3,9 @@ package wait
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
 	"github.com/harleymckenzie/asc/internal/service/cloudformation"
 	"github.com/harleymckenzie/asc/internal/service/ec2"
 	"github.com/harleymckenzie/asc/internal/service/ecs"
@@ -15,125 +18,178 @@ import (
 
 func init() {
 	// EC2
-	RegisterHandler("ec2/instance", newEC2Handler(
+	RegisterHandler("ec2/instance", withFinalStates(newEC2Handler(
 		func(svc *ec2.EC2Service, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
 			return func(ctx context.Context) (string, error) { return svc.GetInstanceStatus(ctx, uri.Resource) }
-		}, ec2.IsTerminalInstanceState,
-	))
+		}, ec2.IsTerminalInstanceState, nil,
+	), ec2.IsFinalInstanceState))
 	RegisterHandler("ec2/volume", newEC2Handler(
 		func(svc *ec2.EC2Service, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
 			return func(ctx context.Context) (string, error) { return svc.GetVolumeStatus(ctx, uri.Resource) }
-		}, ec2.IsTerminalVolumeState,
+		}, ec2.IsTerminalVolumeState, ec2.IsFailedVolumeState,
 	))
 	RegisterHandler("ec2/snapshot", newEC2Handler(
 		func(svc *ec2.EC2Service, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
 			return func(ctx context.Context) (string, error) { return svc.GetSnapshotStatus(ctx, uri.Resource) }
-		}, ec2.IsTerminalSnapshotState,
+		}, ec2.IsTerminalSnapshotState, ec2.IsFailedSnapshotState,
 	))
 	RegisterHandler("ec2/image", newEC2Handler(
 		func(svc *ec2.EC2Service, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
 			return func(ctx context.Context) (string, error) { return svc.GetImageStatus(ctx, uri.Resource) }
-		}, ec2.IsTerminalImageState,
+		}, ec2.IsTerminalImageState, ec2.IsFailedImageState,
 	))
 
 	// RDS
 	RegisterHandler("rds/instance", newRDSHandler(
 		func(svc *rds.RDSService, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
 			return func(ctx context.Context) (string, error) { return svc.GetInstanceStatus(ctx, uri.Resource) }
-		}, rds.IsTerminalInstanceState,
+		}, rds.IsTerminalInstanceState, rds.IsFailedInstanceState,
 	))
 	RegisterHandler("rds/cluster", newRDSHandler(
 		func(svc *rds.RDSService, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
 			return func(ctx context.Context) (string, error) { return svc.GetClusterStatus(ctx, uri.Resource) }
-		}, rds.IsTerminalClusterState,
+		}, rds.IsTerminalClusterState, rds.IsFailedClusterState,
+	))
+	RegisterHandler("rds/snapshot", newRDSHandler(
+		func(svc *rds.RDSService, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
+			return func(ctx context.Context) (string, error) { return svc.GetSnapshotStatus(ctx, uri.Resource) }
+		}, rds.IsTerminalSnapshotState, rds.IsFailedSnapshotState,
+	))
+	RegisterHandler("rds/cluster-snapshot", newRDSHandler(
+		func(svc *rds.RDSService, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
+			return func(ctx context.Context) (string, error) { return svc.GetClusterSnapshotStatus(ctx, uri.Resource) }
+		}, rds.IsTerminalSnapshotState, rds.IsFailedSnapshotState,
 	))
 
 	// CloudFormation
-	RegisterHandler("cf/stack", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (func(ctx context.Context) (string, error), func(string) bool, error) {
+	RegisterHandler("cf/stack", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
 		svc, err := cloudformation.NewCloudFormationService(ctx, profile, region)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		return func(ctx context.Context) (string, error) {
-			return svc.GetStackStatus(ctx, uri.Resource)
-		}, cloudformation.IsTerminalStackStatus, nil
+		return &Waiter{
+			Status: func(ctx context.Context) (string, error) {
+				return svc.GetStackStatus(ctx, uri.Resource)
+			},
+			IsTerminal: cloudformation.IsTerminalStackStatus,
+			IsFailed:   cloudformation.IsFailedStackStatus,
+		}, nil
 	})
 
 	// ElastiCache
-	RegisterHandler("elasticache/cluster", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (func(ctx context.Context) (string, error), func(string) bool, error) {
+	RegisterHandler("elasticache/cluster", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
 		svc, err := elasticache.NewElasticacheService(ctx, profile, region)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		return func(ctx context.Context) (string, error) {
-			return svc.GetClusterStatus(ctx, uri.Resource)
-		}, elasticache.IsTerminalClusterState, nil
+		return &Waiter{
+			Status: func(ctx context.Context) (string, error) {
+				return svc.GetClusterStatus(ctx, uri.Resource)
+			},
+			IsTerminal: elasticache.IsTerminalClusterState,
+			IsFailed:   elasticache.IsFailedClusterState,
+		}, nil
 	})
 
 	// ELB
-	RegisterHandler("elb/load-balancer", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (func(ctx context.Context) (string, error), func(string) bool, error) {
+	RegisterHandler("elb/load-balancer", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
 		svc, err := elb.NewELBService(ctx, profile, region)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		return func(ctx context.Context) (string, error) {
-			return svc.GetLoadBalancerStatus(ctx, uri.Resource)
-		}, elb.IsTerminalLoadBalancerState, nil
+		return &Waiter{
+			Status: func(ctx context.Context) (string, error) {
+				return svc.GetLoadBalancerStatus(ctx, uri.Resource)
+			},
+			IsTerminal: elb.IsTerminalLoadBalancerState,
+			IsFailed:   elb.IsFailedLoadBalancerState,
+		}, nil
 	})
 
 	// VPC
-	RegisterHandler("vpc/nat-gateway", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (func(ctx context.Context) (string, error), func(string) bool, error) {
+	RegisterHandler("vpc/nat-gateway", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
 		svc, err := vpc.NewVPCService(ctx, profile, region)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		return func(ctx context.Context) (string, error) {
-			return svc.GetNatGatewayStatus(ctx, uri.Resource)
-		}, vpc.IsTerminalNatGatewayState, nil
+		return &Waiter{
+			Status: func(ctx context.Context) (string, error) {
+				return svc.GetNatGatewayStatus(ctx, uri.Resource)
+			},
+			IsTerminal: vpc.IsTerminalNatGatewayState,
+			IsFailed:   vpc.IsFailedNatGatewayState,
+		}, nil
 	})
 
 	// ECS
-	RegisterHandler("ecs/service", newECSHandler(
+	RegisterHandler("ecs/service", withFinalStates(newECSHandler(
 		func(svc *ecs.ECSService, cluster string, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
 			return func(ctx context.Context) (string, error) {
 				return svc.GetServiceStatus(ctx, cluster, uri.Resource)
 			}
 		}, ecs.IsTerminalServiceState,
-	))
-	RegisterHandler("ecs/task", newECSHandler(
-		func(svc *ecs.ECSService, cluster string, uri *awsutil.ResourceURI) func(ctx context.Context) (string, error) {
-			return func(ctx context.Context) (string, error) {
-				return svc.GetTaskStatus(ctx, cluster, uri.Resource)
-			}
-		}, ecs.IsTerminalTaskState,
-	))
+	), ecs.IsFinalServiceState))
+	RegisterHandler("ecs/task", func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
+		svc, err := ecs.NewECSService(ctx, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		cluster := uri.Params["cluster"]
+		// Whether a stopped task failed depends on its exit codes, so the last task polled is kept
+		var task types.Task
+		return &Waiter{
+			Status: func(ctx context.Context) (string, error) {
+				var err error
+				if task, err = svc.GetTask(ctx, cluster, uri.Resource); err != nil {
+					return "", err
+				}
+				return aws.ToString(task.LastStatus), nil
+			},
+			IsTerminal: ecs.IsTerminalTaskState,
+			IsFailed:   func(string) bool { return ecs.IsFailedTask(task) },
+		}, nil
+	})
 }
 
 // Helper constructors to reduce boilerplate for services with multiple resource types.
 
+// withFinalStates sets the final states of the waiters returned by handler.
+func withFinalStates(handler WaitHandler, isFinal func(string) bool) WaitHandler {
+	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
+		waiter, err := handler(ctx, profile, region, uri)
+		if err != nil {
+			return nil, err
+		}
+		waiter.IsFinal = isFinal
+		return waiter, nil
+	}
+}
+
 func newEC2Handler(
 	makeStatusFunc func(*ec2.EC2Service, *awsutil.ResourceURI) func(ctx context.Context) (string, error),
 	isTerminal func(string) bool,
+	isFailed func(string) bool,
 ) WaitHandler {
-	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (func(ctx context.Context) (string, error), func(string) bool, error) {
+	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
 		svc, err := ec2.NewEC2Service(ctx, profile, region)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		return makeStatusFunc(svc, uri), isTerminal, nil
+		return &Waiter{Status: makeStatusFunc(svc, uri), IsTerminal: isTerminal, IsFailed: isFailed}, nil
 	}
 }
 
 func newRDSHandler(
 	makeStatusFunc func(*rds.RDSService, *awsutil.ResourceURI) func(ctx context.Context) (string, error),
 	isTerminal func(string) bool,
+	isFailed func(string) bool,
 ) WaitHandler {
-	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (func(ctx context.Context) (string, error), func(string) bool, error) {
+	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
 		svc, err := rds.NewRDSService(ctx, profile, region)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		return makeStatusFunc(svc, uri), isTerminal, nil
+		return &Waiter{Status: makeStatusFunc(svc, uri), IsTerminal: isTerminal, IsFailed: isFailed}, nil
 	}
 }
 
@@ -141,12 +197,12 @@ func newECSHandler(
 	makeStatusFunc func(*ecs.ECSService, string, *awsutil.ResourceURI) func(ctx context.Context) (string, error),
 	isTerminal func(string) bool,
 ) WaitHandler {
-	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (func(ctx context.Context) (string, error), func(string) bool, error) {
+	return func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error) {
 		svc, err := ecs.NewECSService(ctx, profile, region)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		cluster := uri.Params["cluster"]
-		return makeStatusFunc(svc, cluster, uri), isTerminal, nil
+		return &Waiter{Status: makeStatusFunc(svc, cluster, uri), IsTerminal: isTerminal}, nil
 	}
 }
@@ -7,14 +7,32 @@ import (
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 )
 
-// WaitHandler returns a status function and terminal check for a given resource.
-// The status function polls the resource's current state. The terminal function
-// returns true when the state is stable (no longer processing).
-type WaitHandler func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (
-	statusFunc func(ctx context.Context) (string, error),
-	isTerminal func(status string) bool,
-	err error,
-)
+// Waiter polls the state of a single resource.
+type Waiter struct {
+	// Status polls the resource's current state.
+	Status func(ctx context.Context) (string, error)
+	// IsTerminal returns true when the state is stable (no longer processing).
+	IsTerminal func(status string) bool
+	// IsFailed returns true when the state is a failure, such as a CloudFormation rollback.
+	// It is nil for resources without failure states.
+	IsFailed func(status string) bool
+	// IsFinal returns true when the resource can no longer reach states other than its final
+	// ones, such as a terminated EC2 instance. A final state is a failure when waiting for a
+	// state that is not final with --until. It is nil for resources without final states.
+	IsFinal func(status string) bool
+}
+
+// failed returns true if the status is a failure state for the resource, when waiting until the
+// given state.
+func (w *Waiter) failed(until, status string) bool {
+	if w.IsFailed != nil && w.IsFailed(status) {
+		return true
+	}
+	return w.IsFinal != nil && until != "" && w.IsFinal(status) && !w.IsFinal(until)
+}
+
+// WaitHandler returns a Waiter for a given resource.
+type WaitHandler func(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) (*Waiter, error)
 
 var handlers = map[string]WaitHandler{}
 
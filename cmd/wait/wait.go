@@ -1,21 +1,64 @@
 package wait
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
 	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 	"github.com/spf13/cobra"
 )
 
+const defaultTimeout = 30 * time.Minute
+
+var (
+	until     string
+	waitAny   bool
+	timeout   time.Duration
+	quiet     bool
+	events    bool
+	execHook  string
+	notifyURL string
+)
+
+// AddFlags adds the flags shared by the wait command family: --quiet and --events, which
+// control how status changes are printed, and the --exec and --notify-url completion hooks.
+func AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Print nothing but errors")
+	cmd.Flags().BoolVar(&events, "events", false, "Print a JSON event per line for each status change")
+	cmd.Flags().StringVar(&execHook, "exec", "", "Command to run when the wait completes, with the final status in ASC_WAIT_* environment variables")
+	cmd.Flags().StringVar(&notifyURL, "notify-url", "", "URL to POST a JSON payload to when the wait completes")
+	cmd.MarkFlagsMutuallyExclusive("quiet", "events")
+	// Polling must see each change of status, so waits never read cached responses
+	cmdutil.BypassCache(cmd)
+}
+
 // NewWaitCmd creates the top-level wait command.
 func NewWaitCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "wait <protocol://resource>",
-		Short: "Wait for an AWS resource to reach a stable state",
-		Long: `Wait for an AWS resource to reach a stable state (e.g. available, running, stopped).
+		Use:   "wait [protocol://resource...]",
+		Short: "Wait for AWS resources to reach a stable state",
+		Long: `Wait for one or more AWS resources to reach a stable state (e.g. available, running, stopped).
+
+Resources are polled concurrently. By default, or with --all, the command waits for all
+resources; with --any it returns as soon as one resource succeeds. Use --until to wait for a
+specific state instead of any stable state, including a failure state such as failed.
+
+Exit codes:
+  0  all resources (or any, with --any) reached the expected state
+  1  an error occurred while polling
+  2  a resource reached a failure state (e.g. CloudFormation ROLLBACK_COMPLETE, an ECS
+     task that stopped with a non-zero exit code, or an EC2 instance that was terminated
+     while waiting --until another state)
+  3  the timeout was reached
 
 Supports protocol-style URIs to identify the service and resource type:
   ec2://i-xxx                            EC2 instance
@@ -24,6 +67,8 @@ Supports protocol-style URIs to identify the service and resource type:
   ec2://image/ami-xxx                    EC2 AMI
   rds://my-database                      RDS instance
   rds://cluster/my-cluster               RDS cluster
+  rds://snapshot/my-snapshot             RDS snapshot
+  rds://cluster-snapshot/my-snapshot     RDS cluster snapshot
   cf://my-stack                          CloudFormation stack
   elasticache://my-cluster               ElastiCache cluster
   elb://my-lb                            ELB load balancer
@@ -32,57 +77,368 @@ Supports protocol-style URIs to identify the service and resource type:
   ecs://task/my-cluster/task-id          ECS task
 
 Resources with known ID prefixes can omit the protocol:
-  i-xxx, vol-xxx, snap-xxx, ami-xxx, nat-xxx`,
+  i-xxx, vol-xxx, snap-xxx, ami-xxx, nat-xxx
+
+Without a resource, or with @, the resources selected with 'asc select' are waited on.`,
 		Example: `  asc wait ec2://i-1234567890abcdef0
   asc wait rds://my-database
   asc wait cf://my-stack
   asc wait ecs://service/my-cluster/my-service
   asc wait i-1234567890abcdef0
-  asc wait nat-1234567890abcdef0`,
-		Args: cobra.ExactArgs(1),
+  asc wait nat-1234567890abcdef0
+  asc wait i-0abc i-0def --until stopped
+  asc wait cf://stack-a cf://stack-b --timeout 1h
+  asc wait rds://replica-1 rds://replica-2 --any
+  asc wait @ --until stopped`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmdutil.DefaultErrorHandler(runWait(cmd, args))
 		},
 	}
+	cmd.Flags().StringVar(&until, "until", "", "Wait until the resources reach this state (e.g. stopped)")
+	cmd.Flags().BoolVar(&waitAny, "any", false, "Return as soon as any resource reaches the expected state")
+	cmd.Flags().Bool("all", false, "Wait for all resources to reach the expected state (default)")
+	cmd.MarkFlagsMutuallyExclusive("any", "all")
+	cmd.Flags().DurationVar(&timeout, "timeout", defaultTimeout, "Maximum time to wait")
+	AddFlags(cmd)
 	return cmd
 }
 
 func runWait(cmd *cobra.Command, args []string) error {
-	uri, err := awsutil.ParseResourceURI(args[0])
+	args, err := cmdutil.ResolveSelection(cmd, args)
 	if err != nil {
 		return err
 	}
+	uris := make([]*awsutil.ResourceURI, 0, len(args))
+	for _, arg := range args {
+		uri, err := awsutil.ParseResourceURI(arg)
+		if err != nil {
+			return err
+		}
+		uris = append(uris, uri)
+	}
 
 	profile, region := cmdutil.GetPersistentFlags(cmd)
-	return ExecuteWait(cmd.Context(), profile, region, uri)
+	return execute(cmd.Context(), profile, region, uris, Options{
+		Until:   until,
+		Any:     waitAny,
+		Timeout: timeout,
+		Quiet:   quiet,
+		Events:  events,
+		Exec:    execHook,
+		Notify:  notifyURL,
+	})
+}
+
+// Options configures how resources are waited on.
+type Options struct {
+	Until   string        // State to wait for. If empty, any stable state is accepted.
+	Any     bool          // Return as soon as one resource succeeds, rather than waiting for all.
+	Timeout time.Duration // Maximum time to wait.
+	Quiet   bool          // Print nothing but errors.
+	Events  bool          // Print a JSON event per status change instead of text.
+	Exec    string        // Command to run for each resource when the wait completes.
+	Notify  string        // URL to POST the result of each resource to when the wait completes.
 }
 
 // ExecuteWait is the shared wait implementation used by both the top-level
 // `asc wait` command and per-service `asc <service> wait` commands.
 func ExecuteWait(ctx context.Context, profile, region string, uri *awsutil.ResourceURI) error {
-	handler, err := getHandler(uri)
-	if err != nil {
-		return err
+	return execute(ctx, profile, region, []*awsutil.ResourceURI{uri}, Options{
+		Timeout: defaultTimeout,
+		Quiet:   quiet,
+		Events:  events,
+		Exec:    execHook,
+		Notify:  notifyURL,
+	})
+}
+
+// Results of waiting on a single resource.
+const (
+	resultWaiting   = ""
+	resultSucceeded = "succeeded"
+	resultFailed    = "failed"
+	resultTimeout   = "timed out"
+	resultCancelled = "cancelled"
+	resultError     = "error"
+)
+
+// waitState is the progress of waiting on a single resource.
+type waitState struct {
+	uri     *awsutil.ResourceURI
+	waiter  *Waiter
+	status  string
+	elapsed time.Duration
+	result  string
+	err     error
+}
+
+// done returns true once the resource has reached the expected state or a failure state. The
+// state given with --until is checked first, so that a failure state can be waited for.
+func (s *waitState) done(opts Options, status string) bool {
+	if opts.Until != "" && strings.EqualFold(status, opts.Until) {
+		return true
+	}
+	if s.waiter.failed(opts.Until, status) {
+		return true
 	}
+	if opts.Until != "" {
+		return false
+	}
+	return s.waiter.IsTerminal(status)
+}
 
-	statusFunc, isTerminal, err := handler(ctx, profile, region, uri)
-	if err != nil {
-		return fmt.Errorf("create %s service: %w", uri.Service, err)
+// finish records the result of waiting on the resource, from the final status and the error
+// returned by the wait.
+func (s *waitState) finish(opts Options, status string, err error) {
+	s.err = err
+	switch {
+	case err == nil && s.waiter.failed(opts.Until, status) && !strings.EqualFold(status, opts.Until):
+		s.result = resultFailed
+	case err == nil:
+		s.result = resultSucceeded
+	case errors.Is(err, awsutil.ErrWaitTimeout):
+		s.result = resultTimeout
+	case errors.Is(err, context.Canceled):
+		s.result = resultCancelled
+	default:
+		s.result = resultError
+	}
+}
+
+func execute(ctx context.Context, profile, region string, uris []*awsutil.ResourceURI, opts Options) error {
+	states := make([]*waitState, len(uris))
+	for i, uri := range uris {
+		handler, err := getHandler(uri)
+		if err != nil {
+			return err
+		}
+		waiter, err := handler(ctx, profile, region, uri)
+		if err != nil {
+			return fmt.Errorf("create %s service: %w", uri.Service, err)
+		}
+		states[i] = &waitState{uri: uri, waiter: waiter}
+	}
+
+	target := "a stable state"
+	if opts.Until != "" {
+		target = "state " + opts.Until
+	}
+	switch {
+	case opts.Quiet || opts.Events:
+	case len(states) == 1:
+		uri := states[0].uri
+		fmt.Printf("Waiting for %s %s %s to reach %s...\n", uri.Service, uri.ResourceType, uri.Resource, target)
+	case !liveTable() && !tablewriter.IsMachineReadable():
+		fmt.Printf("Waiting for %d resources to reach %s...\n", len(states), target)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	output := &syncWriter{w: os.Stdout}
+	for _, state := range states {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, err := awsutil.WaitForStatus(ctx, awsutil.WaitConfig{
+				ResourceName: state.uri.String(),
+				PollInterval: awsutil.DefaultPollInterval,
+				MaxInterval:  awsutil.DefaultMaxPollInterval,
+				Backoff:      awsutil.DefaultBackoff,
+				Jitter:       awsutil.DefaultJitter,
+				MaxWait:      opts.Timeout,
+				StatusFunc:   state.waiter.Status,
+				IsTerminal:   func(status string) bool { return state.done(opts, status) },
+				Output:       output,
+				Quiet:        opts.Quiet || (len(states) > 1 && !opts.Events),
+				Events:       opts.Events,
+				OnProgress: func(event awsutil.WaitEvent) {
+					mu.Lock()
+					defer mu.Unlock()
+					state.status, state.elapsed = event.Status, event.Elapsed
+					if event.Changed() && showLines(opts, len(states)) {
+						fmt.Fprintf(output, "%s: %s (%s elapsed)\n", state.uri, event.Status, event.Elapsed)
+					}
+				},
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			state.finish(opts, status, err)
+			if state.result == resultSucceeded && opts.Any {
+				cancel()
+			}
+		}()
+	}
+
+	stopRender := renderLive(states, opts, &mu)
+	wg.Wait()
+	stopRender()
+
+	err := summarise(states, opts)
+	if hookErr := runHooks(context.WithoutCancel(ctx), states, opts); hookErr != nil {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", hookErr)
+			return err
+		}
+		return hookErr
+	}
+	return err
+}
+
+// runHooks runs the --exec command and posts to the --notify-url for each resource.
+func runHooks(ctx context.Context, states []*waitState, opts Options) error {
+	if opts.Exec == "" && opts.Notify == "" {
+		return nil
+	}
+
+	var errs []error
+	for _, state := range states {
+		result := cmdutil.WaitResult{
+			URI:      state.uri.String(),
+			Status:   state.status,
+			Result:   state.result,
+			Duration: state.elapsed,
+			Success:  state.result == resultSucceeded,
+		}
+		if opts.Exec != "" {
+			errs = append(errs, cmdutil.RunHook(ctx, opts.Exec, result))
+		}
+		if opts.Notify != "" {
+			errs = append(errs, cmdutil.PostWebhook(ctx, opts.Notify, result))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// syncWriter serialises writes from the goroutines waiting on each resource.
+type syncWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *syncWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Write(p)
+}
+
+// showLines returns true if status changes of several resources are printed as lines of text,
+// which is the case when stdout is not a terminal.
+func showLines(opts Options, resources int) bool {
+	return resources > 1 && !opts.Quiet && !opts.Events && !liveTable() && !tablewriter.IsMachineReadable()
+}
+
+// liveTable returns true if the status of several resources is shown in a table that is
+// redrawn as it changes. This requires stdout to be a terminal.
+func liveTable() bool {
+	if tablewriter.IsMachineReadable() {
+		return false
 	}
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
 
-	fmt.Printf("Waiting for %s %s %s to reach a stable state...\n", uri.Service, uri.ResourceType, uri.Resource)
+// renderLive redraws the status table every second until the returned function is called,
+// which draws the final table. Machine-readable output is written once, when stopped.
+func renderLive(states []*waitState, opts Options, mu *sync.Mutex) (stop func()) {
+	if len(states) == 1 || opts.Quiet || opts.Events || (!liveTable() && !tablewriter.IsMachineReadable()) {
+		return func() {}
+	}
+
+	start := time.Now()
+	lines := 0
+	draw := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		var buf bytes.Buffer
+		tablewriter.Output = &buf
+		renderTable(states, time.Since(start))
+		tablewriter.Output = os.Stdout
+		if lines > 0 {
+			fmt.Printf("\033[%dA\033[J", lines)
+		}
+		os.Stdout.Write(buf.Bytes())
+		lines = bytes.Count(buf.Bytes(), []byte("\n"))
+	}
+
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		if tablewriter.IsMachineReadable() {
+			<-done
+			return
+		}
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			draw()
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-finished
+		draw()
+	}
+}
 
-	finalStatus, err := awsutil.WaitForStatus(ctx, awsutil.WaitConfig{
-		ResourceName: uri.Resource,
-		PollInterval: 10 * time.Second,
-		MaxWait:      30 * time.Minute,
-		StatusFunc:   statusFunc,
-		IsTerminal:   isTerminal,
+// renderTable writes the status of each resource as a table.
+func renderTable(states []*waitState, elapsed time.Duration) {
+	table := tablewriter.NewAscWriter(tablewriter.AscTableRenderOptions{
+		Title: fmt.Sprintf("Waiting (%s)", elapsed.Truncate(time.Second)),
 	})
-	if err != nil {
-		return err
+	table.AppendHeader([]string{"Resource", "Status", "Elapsed", "Result"})
+	for _, state := range states {
+		table.AppendRow(tablewriter.Row{Values: []string{
+			state.uri.String(), state.status, state.elapsed.String(), state.result,
+		}})
+	}
+	table.Render()
+}
+
+// summarise prints the final state of a single resource and returns an error with the
+// exit code for the overall result. Failures take precedence over timeouts, which take
+// precedence over other errors.
+func summarise(states []*waitState, opts Options) error {
+	var failed, timedOut, errs []error
+	succeeded := 0
+	for _, state := range states {
+		switch state.result {
+		case resultSucceeded:
+			succeeded++
+		case resultFailed:
+			failed = append(failed, fmt.Errorf("%s reached failure state: %s", state.uri, state.status))
+		case resultTimeout:
+			timedOut = append(timedOut, fmt.Errorf("%s: %w", state.uri, state.err))
+		case resultError:
+			errs = append(errs, fmt.Errorf("%s: %w", state.uri, state.err))
+		}
+	}
+
+	if len(states) == 1 && succeeded == 1 && !opts.Quiet && !opts.Events {
+		uri := states[0].uri
+		fmt.Printf("%s %s %s reached state: %s\n", uri.Service, uri.ResourceType, uri.Resource, states[0].status)
 	}
 
-	fmt.Printf("%s %s %s reached state: %s\n", uri.Service, uri.ResourceType, uri.Resource, finalStatus)
-	return nil
+	switch {
+	case opts.Any && succeeded > 0, succeeded == len(states):
+		return nil
+	case len(failed) > 0:
+		return &cmdutil.ExitError{Code: cmdutil.ExitCodeWaitFailed, Err: errors.Join(failed...)}
+	case len(timedOut) > 0:
+		return &cmdutil.ExitError{Code: cmdutil.ExitCodeWaitTimeout, Err: errors.Join(timedOut...)}
+	case len(errs) > 0:
+		return errors.Join(errs...)
+	default:
+		return context.Canceled
+	}
 }
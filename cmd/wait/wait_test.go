@@ -0,0 +1,185 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/service/ec2"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stackWaiter is a Waiter with the stable and failure states of a CloudFormation stack.
+func stackWaiter() *Waiter {
+	return &Waiter{
+		IsTerminal: func(status string) bool {
+			return slices.Contains([]string{"CREATE_COMPLETE", "ROLLBACK_COMPLETE", "DELETE_FAILED"}, status)
+		},
+		IsFailed: func(status string) bool {
+			return slices.Contains([]string{"ROLLBACK_COMPLETE", "DELETE_FAILED"}, status)
+		},
+	}
+}
+
+// instanceWaiter is a Waiter with the stable and final states of an EC2 instance.
+func instanceWaiter() *Waiter {
+	return &Waiter{IsTerminal: ec2.IsTerminalInstanceState, IsFinal: ec2.IsFinalInstanceState}
+}
+
+func newState(t *testing.T, resource string) *waitState {
+	t.Helper()
+	uri, err := awsutil.ParseResourceURI("cf://" + resource)
+	require.NoError(t, err)
+	return &waitState{uri: uri, waiter: stackWaiter()}
+}
+
+// Unit test for waitState.done
+func TestWaitStateDone(t *testing.T) {
+	tests := []struct {
+		name   string
+		until  string
+		status string
+		want   bool
+	}{
+		{name: "in progress", status: "CREATE_IN_PROGRESS", want: false},
+		{name: "stable", status: "CREATE_COMPLETE", want: true},
+		{name: "failure ends the wait", status: "ROLLBACK_COMPLETE", want: true},
+		{name: "until reached", until: "create_complete", status: "CREATE_COMPLETE", want: true},
+		{name: "stable but not until", until: "DELETE_COMPLETE", status: "CREATE_COMPLETE", want: false},
+		{name: "failure while waiting for another state", until: "CREATE_COMPLETE", status: "ROLLBACK_COMPLETE", want: true},
+		{name: "until a failure state", until: "DELETE_FAILED", status: "DELETE_FAILED", want: true},
+		{name: "until an unstable state", until: "UPDATE_IN_PROGRESS", status: "UPDATE_IN_PROGRESS", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newState(t, "my-stack").done(Options{Until: tt.until}, tt.status))
+		})
+	}
+}
+
+// Unit test for waitState.finish
+func TestWaitStateFinish(t *testing.T) {
+	tests := []struct {
+		name   string
+		until  string
+		status string
+		err    error
+		want   string
+	}{
+		{name: "stable", status: "CREATE_COMPLETE", want: resultSucceeded},
+		{name: "failure state", status: "ROLLBACK_COMPLETE", want: resultFailed},
+		{name: "failure state while waiting for another", until: "CREATE_COMPLETE", status: "DELETE_FAILED", want: resultFailed},
+		{name: "failure state given with until", until: "delete_failed", status: "DELETE_FAILED", want: resultSucceeded},
+		{name: "timeout", err: fmt.Errorf("my-stack: %w", awsutil.ErrWaitTimeout), want: resultTimeout},
+		{name: "cancelled", err: context.Canceled, want: resultCancelled},
+		{name: "error", err: errors.New("AccessDenied"), want: resultError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := newState(t, "my-stack")
+			state.finish(Options{Until: tt.until}, tt.status, tt.err)
+			assert.Equal(t, tt.want, state.result)
+			assert.Equal(t, tt.err, state.err)
+		})
+	}
+}
+
+// Unit test for summarise and the precedence of its exit codes
+func TestSummarise(t *testing.T) {
+	timeout := fmt.Errorf("wait: %w", awsutil.ErrWaitTimeout)
+	tests := []struct {
+		name     string
+		any      bool
+		results  []string
+		wantCode int // 0 for no error, -1 for an error without an exit code
+		wantErr  error
+	}{
+		{name: "all succeeded", results: []string{resultSucceeded, resultSucceeded}},
+		{name: "one failed", results: []string{resultSucceeded, resultFailed}, wantCode: cmdutil.ExitCodeWaitFailed},
+		{name: "failure over timeout", results: []string{resultTimeout, resultFailed, resultError}, wantCode: cmdutil.ExitCodeWaitFailed},
+		{name: "timeout over error", results: []string{resultError, resultTimeout}, wantCode: cmdutil.ExitCodeWaitTimeout},
+		{name: "error", results: []string{resultSucceeded, resultError}, wantCode: -1},
+		{name: "any with one success", any: true, results: []string{resultCancelled, resultSucceeded, resultCancelled}},
+		{name: "any with one success and a failure", any: true, results: []string{resultFailed, resultSucceeded}},
+		{name: "any without success", any: true, results: []string{resultFailed, resultTimeout}, wantCode: cmdutil.ExitCodeWaitFailed},
+		{name: "all cancelled", results: []string{resultCancelled, resultCancelled}, wantErr: context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var states []*waitState
+			for i, result := range tt.results {
+				state := newState(t, fmt.Sprintf("stack-%d", i))
+				state.result = result
+				state.status = "CREATE_COMPLETE"
+				switch result {
+				case resultTimeout:
+					state.err = timeout
+				case resultError:
+					state.err = errors.New("AccessDenied")
+				}
+				states = append(states, state)
+			}
+
+			err := summarise(states, Options{Any: tt.any, Quiet: true})
+			var exitErr *cmdutil.ExitError
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantCode == 0:
+				assert.NoError(t, err)
+			case tt.wantCode < 0:
+				require.Error(t, err)
+				assert.False(t, errors.As(err, &exitErr), "no exit code for %v", err)
+			default:
+				require.ErrorAs(t, err, &exitErr)
+				assert.Equal(t, tt.wantCode, exitErr.Code)
+			}
+		})
+	}
+}
+
+// Unit test for --all and --any being mutually exclusive
+func TestAllAndAnyFlags(t *testing.T) {
+	cmd := NewWaitCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--all"}))
+	assert.NoError(t, cmd.ValidateFlagGroups())
+
+	cmd = NewWaitCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--all", "--any"}))
+	assert.ErrorContains(t, cmd.ValidateFlagGroups(), "none of the others can be")
+}
+
+// Unit test for final states, which only fail a wait for a state that is not final
+func TestWaitStateFinal(t *testing.T) {
+	tests := []struct {
+		name     string
+		until    string
+		status   string
+		wantDone bool
+		want     string
+	}{
+		{name: "terminated without until", status: "terminated", wantDone: true, want: resultSucceeded},
+		{name: "shutting down without until", status: "shutting-down", wantDone: false},
+		{name: "terminated while waiting for running", until: "running", status: "terminated", wantDone: true, want: resultFailed},
+		{name: "shutting down while waiting for stopped", until: "stopped", status: "shutting-down", wantDone: true, want: resultFailed},
+		{name: "shutting down while waiting for terminated", until: "terminated", status: "shutting-down", wantDone: false},
+		{name: "terminated given with until", until: "terminated", status: "terminated", wantDone: true, want: resultSucceeded},
+		{name: "stopped while waiting for running", until: "running", status: "stopped", wantDone: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &waitState{waiter: instanceWaiter()}
+			opts := Options{Until: tt.until}
+			assert.Equal(t, tt.wantDone, state.done(opts, tt.status))
+			if tt.wantDone {
+				state.finish(opts, tt.status, nil)
+				assert.Equal(t, tt.want, state.result)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package watch
+
+import (
+	"fmt"
+
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+var interval string
+
+// NewWatchCmd creates the top-level watch command.
+func NewWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [-n interval] <command...>",
+		Short: "Re-run a list or show command on an interval",
+		Long: `Re-run a list or show command on an interval, redrawing the output in place and
+highlighting the cells that changed since the previous run.
+
+This is the same as adding --watch to the command. Flags for the watched command go after
+the command, and flags for watch go before it. Press Ctrl+C to stop.`,
+		Example: `  asc watch asg ls my-asg
+  asc watch -n 10s ecs task ls --cluster my-cluster
+  asc watch rds show my-database
+  asc ecs service ls --cluster my-cluster --watch 10s`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdutil.DefaultErrorHandler(runWatch(cmd, args))
+		},
+	}
+	cmd.Flags().StringVarP(&interval, "interval", "n", cmdutil.DefaultWatchInterval.String(),
+		"Interval between runs, as a duration (e.g. 30s) or a number of seconds")
+	// Stop parsing flags at the first argument so that the watched command's flags are
+	// passed through to it.
+	cmd.Flags().SetInterspersed(false)
+	return cmd
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	d, err := cmdutil.ParseInterval(interval)
+	if err != nil {
+		return err
+	}
+
+	root := cmd.Root()
+	target, _, err := root.Find(args)
+	if err != nil {
+		return err
+	}
+	if target == root || target.Flags().Lookup("watch") == nil {
+		return fmt.Errorf("%q cannot be watched, only list and show commands support watch", target.CommandPath())
+	}
+
+	root.SetArgs(append(args, "--watch="+d.String()))
+	return root.Execute()
+}
@@ -34,8 +34,8 @@ func NewAutoScalingService(ctx context.Context, profile string, region string) (
 	}
 
 
-	client := autoscaling.NewFromConfig(cfg.Config)
-	return &AutoScalingService{Client: client}, nil
+	client := autoscaling.NewFromConfig(cfg.ServiceConfig("autoscaling"))
+	return &AutoScalingService{Client: newCachedClient(client, cfg)}, nil
 }
 
 func (svc *AutoScalingService) AddAutoScalingGroupSchedule(ctx context.Context, input *ascTypes.AddAutoScalingGroupScheduleInput) error {
@@ -58,31 +58,25 @@ func (svc *AutoScalingService) AddAutoScalingGroupSchedule(ctx context.Context,
 }
 
 func (svc *AutoScalingService) GetAutoScalingGroups(ctx context.Context, input *ascTypes.GetAutoScalingGroupsInput) ([]types.AutoScalingGroup, error) {
-	output, err := svc.Client.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(svc.Client, &autoscaling.DescribeAutoScalingGroupsInput{
 		AutoScalingGroupNames: input.AutoScalingGroupNames,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var autoScalingGroups []types.AutoScalingGroup
-	autoScalingGroups = append(autoScalingGroups, output.AutoScalingGroups...)
-	return autoScalingGroups, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *autoscaling.DescribeAutoScalingGroupsOutput) []types.AutoScalingGroup {
+		return page.AutoScalingGroups
+	})
 }
 
 func (svc *AutoScalingService) GetAutoScalingGroupInstances(ctx context.Context, input *ascTypes.GetAutoScalingGroupInstancesInput) ([]types.Instance, error) {
-	output, err := svc.Client.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(svc.Client, &autoscaling.DescribeAutoScalingGroupsInput{
 		AutoScalingGroupNames: input.AutoScalingGroupNames,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var instances []types.Instance
-	for _, autoScalingGroups := range output.AutoScalingGroups {
-		instances = append(instances, autoScalingGroups.Instances...)
-	}
-	return instances, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *autoscaling.DescribeAutoScalingGroupsOutput) []types.Instance {
+		var instances []types.Instance
+		for _, autoScalingGroups := range page.AutoScalingGroups {
+			instances = append(instances, autoScalingGroups.Instances...)
+		}
+		return instances
+	})
 }
 
 func (svc *AutoScalingService) GetAutoScalingGroupSchedules(ctx context.Context, input *ascTypes.GetAutoScalingGroupSchedulesInput) ([]types.ScheduledUpdateGroupAction, error) {
@@ -94,14 +88,10 @@ func (svc *AutoScalingService) GetAutoScalingGroupSchedules(ctx context.Context,
 		describeScheduledActionsInput.ScheduledActionNames = input.ScheduledActionNames
 	}
 
-	output, err := svc.Client.DescribeScheduledActions(ctx, describeScheduledActionsInput)
-	if err != nil {
-		return nil, err
-	}
-
-	var schedules []types.ScheduledUpdateGroupAction
-	schedules = append(schedules, output.ScheduledUpdateGroupActions...)
-	return schedules, nil
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(svc.Client, describeScheduledActionsInput)
+	return awsutil.CollectPages(ctx, paginator, func(page *autoscaling.DescribeScheduledActionsOutput) []types.ScheduledUpdateGroupAction {
+		return page.ScheduledUpdateGroupActions
+	})
 }
 
 func (svc *AutoScalingService) ModifyAutoScalingGroup(ctx context.Context, input *ascTypes.ModifyAutoScalingGroupInput) error {
@@ -0,0 +1,48 @@
+package asg
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an AutoScalingClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	AutoScalingClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client AutoScalingClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{AutoScalingClientAPI: client, cache: awsutil.NewResponseCache(cfg, "autoscaling")}
+}
+
+func (c *cachedClient) DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeAutoScalingGroups", params, func() (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+		return c.AutoScalingClientAPI.DescribeAutoScalingGroups(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeScheduledActions(ctx context.Context, params *autoscaling.DescribeScheduledActionsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeScheduledActions", params, func() (*autoscaling.DescribeScheduledActionsOutput, error) {
+		return c.AutoScalingClientAPI.DescribeScheduledActions(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) PutScheduledUpdateGroupAction(ctx context.Context, params *autoscaling.PutScheduledUpdateGroupActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	defer c.cache.Invalidate()
+	return c.AutoScalingClientAPI.PutScheduledUpdateGroupAction(ctx, params, optFns...)
+}
+
+func (c *cachedClient) DeleteScheduledAction(ctx context.Context, params *autoscaling.DeleteScheduledActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteScheduledActionOutput, error) {
+	defer c.cache.Invalidate()
+	return c.AutoScalingClientAPI.DeleteScheduledAction(ctx, params, optFns...)
+}
+
+func (c *cachedClient) UpdateAutoScalingGroup(ctx context.Context, params *autoscaling.UpdateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	defer c.cache.Invalidate()
+	return c.AutoScalingClientAPI.UpdateAutoScalingGroup(ctx, params, optFns...)
+}
@@ -0,0 +1,118 @@
+package asg
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	ascTypes "github.com/harleymckenzie/asc/internal/service/asg/types"
+)
+
+// CapacityState returns the capacity of an Auto Scaling Group as strings, for recording in the
+// journal.
+func CapacityState(group types.AutoScalingGroup) map[string]string {
+	return map[string]string{
+		"min":     strconv.Itoa(int(aws.ToInt32(group.MinSize))),
+		"max":     strconv.Itoa(int(aws.ToInt32(group.MaxSize))),
+		"desired": strconv.Itoa(int(aws.ToInt32(group.DesiredCapacity))),
+	}
+}
+
+// CapacityInputFromState returns the input to restore the capacity returned by CapacityState.
+func CapacityInputFromState(asgName string, state map[string]string) (*ascTypes.ModifyAutoScalingGroupInput, error) {
+	input := &ascTypes.ModifyAutoScalingGroupInput{AutoScalingGroupName: asgName}
+	var err error
+	if input.MinSize, err = stateInt(state, "min"); err != nil {
+		return nil, err
+	}
+	if input.MaxSize, err = stateInt(state, "max"); err != nil {
+		return nil, err
+	}
+	if input.DesiredCapacity, err = stateInt(state, "desired"); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ScheduleState returns the settings of a scheduled action as strings, for recording in the
+// journal. Settings that are not set are omitted.
+func ScheduleState(action types.ScheduledUpdateGroupAction) map[string]string {
+	state := map[string]string{}
+	setInt := func(key string, value *int32) {
+		if value != nil {
+			state[key] = strconv.Itoa(int(*value))
+		}
+	}
+	setTime := func(key string, value *time.Time) {
+		if value != nil {
+			state[key] = value.Format(time.RFC3339)
+		}
+	}
+	setInt("min", action.MinSize)
+	setInt("max", action.MaxSize)
+	setInt("desired", action.DesiredCapacity)
+	setTime("start_time", action.StartTime)
+	setTime("end_time", action.EndTime)
+	if action.Recurrence != nil {
+		state["recurrence"] = aws.ToString(action.Recurrence)
+	}
+	return state
+}
+
+// ScheduleInputFromState returns the input to recreate a scheduled action from the settings
+// returned by ScheduleState.
+func ScheduleInputFromState(asgName string, name string, state map[string]string) (*ascTypes.AddAutoScalingGroupScheduleInput, error) {
+	input := &ascTypes.AddAutoScalingGroupScheduleInput{
+		AutoScalingGroupName: asgName,
+		ScheduledActionName:  name,
+	}
+	var err error
+	if input.MinSize, err = stateInt(state, "min"); err != nil {
+		return nil, err
+	}
+	if input.MaxSize, err = stateInt(state, "max"); err != nil {
+		return nil, err
+	}
+	if input.DesiredCapacity, err = stateInt(state, "desired"); err != nil {
+		return nil, err
+	}
+	if input.StartTime, err = stateTime(state, "start_time"); err != nil {
+		return nil, err
+	}
+	if input.EndTime, err = stateTime(state, "end_time"); err != nil {
+		return nil, err
+	}
+	if recurrence, ok := state["recurrence"]; ok {
+		input.Recurrence = &recurrence
+	}
+	return input, nil
+}
+
+// stateInt parses an integer setting, returning nil if it is not set.
+func stateInt(state map[string]string, key string) (*int32, error) {
+	value, ok := state[key]
+	if !ok {
+		return nil, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return aws.Int32(int32(n)), nil
+}
+
+// stateTime parses a time setting, returning nil if it is not set.
+func stateTime(state map[string]string, key string) (*time.Time, error) {
+	value, ok := state[key]
+	if !ok {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,55 @@
+package asg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleStateRoundTrip(t *testing.T) {
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	action := types.ScheduledUpdateGroupAction{
+		MinSize:    aws.Int32(2),
+		MaxSize:    aws.Int32(10),
+		StartTime:  &start,
+		Recurrence: aws.String("0 9 * * MON-FRI"),
+	}
+
+	state := ScheduleState(action)
+	assert.Equal(t, map[string]string{
+		"min":        "2",
+		"max":        "10",
+		"start_time": "2026-03-01T09:00:00Z",
+		"recurrence": "0 9 * * MON-FRI",
+	}, state)
+
+	input, err := ScheduleInputFromState("my-asg", "scale-up", state)
+	require.NoError(t, err)
+	assert.Equal(t, "my-asg", input.AutoScalingGroupName)
+	assert.Equal(t, "scale-up", input.ScheduledActionName)
+	assert.Equal(t, int32(2), *input.MinSize)
+	assert.Equal(t, int32(10), *input.MaxSize)
+	assert.Nil(t, input.DesiredCapacity)
+	assert.True(t, start.Equal(*input.StartTime))
+	assert.Nil(t, input.EndTime)
+	assert.Equal(t, "0 9 * * MON-FRI", *input.Recurrence)
+}
+
+func TestCapacityInputFromState(t *testing.T) {
+	input, err := CapacityInputFromState("my-asg", CapacityState(types.AutoScalingGroup{
+		MinSize:         aws.Int32(1),
+		MaxSize:         aws.Int32(4),
+		DesiredCapacity: aws.Int32(3),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), *input.MinSize)
+	assert.Equal(t, int32(4), *input.MaxSize)
+	assert.Equal(t, int32(3), *input.DesiredCapacity)
+
+	_, err = CapacityInputFromState("my-asg", map[string]string{"min": "one"})
+	assert.ErrorContains(t, err, "invalid min")
+}
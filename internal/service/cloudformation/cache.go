@@ -0,0 +1,32 @@
+package cloudformation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an CloudFormationClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	CloudFormationClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client CloudFormationClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{CloudFormationClientAPI: client, cache: awsutil.NewResponseCache(cfg, "cloudformation")}
+}
+
+func (c *cachedClient) DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeStacks", params, func() (*cloudformation.DescribeStacksOutput, error) {
+		return c.CloudFormationClientAPI.DescribeStacks(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) UpdateStack(ctx context.Context, params *cloudformation.UpdateStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateStackOutput, error) {
+	defer c.cache.Invalidate()
+	return c.CloudFormationClientAPI.UpdateStack(ctx, params, optFns...)
+}
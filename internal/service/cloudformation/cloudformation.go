@@ -13,6 +13,7 @@ import (
 // CloudFormationClientAPI is an interface that defines the methods for the CloudFormation client.
 type CloudFormationClientAPI interface {
 	DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)
+	UpdateStack(ctx context.Context, params *cloudformation.UpdateStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateStackOutput, error)
 }
 
 // CloudFormationService is a struct that holds the CloudFormation client.
@@ -31,21 +32,19 @@ func NewCloudFormationService(ctx context.Context, profile string, region string
 	}
 
 	// Create a new CloudFormation client
-	client := cloudformation.NewFromConfig(cfg.Config)
+	client := cloudformation.NewFromConfig(cfg.ServiceConfig("cloudformation"))
 
 	// Return a new CloudFormation service with the client
 	return &CloudFormationService{
-		Client: client,
+		Client: newCachedClient(client, cfg),
 	}, nil
 }
 
 func (svc *CloudFormationService) GetStacks(ctx context.Context, input *ascTypes.GetStacksInput) ([]types.Stack, error) {
-	output, err := svc.Client.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+	paginator := cloudformation.NewDescribeStacksPaginator(svc.Client, &cloudformation.DescribeStacksInput{
 		StackName: input.StackName,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return output.Stacks, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *cloudformation.DescribeStacksOutput) []types.Stack {
+		return page.Stacks
+	})
 }
@@ -5,7 +5,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // Integration test for NewCloudFormationService (skipped unless INTEGRATION=1)
@@ -17,3 +21,91 @@ func TestNewCloudFormationService_Integration(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, svc)
 }
+
+// mockCloudFormationClient is a mock implementation of CloudFormationClientAPI for unit tests.
+type mockCloudFormationClient struct {
+	mock.Mock
+}
+
+func (m *mockCloudFormationClient) DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(*cloudformation.DescribeStacksOutput), args.Error(1)
+}
+
+func (m *mockCloudFormationClient) UpdateStack(ctx context.Context, params *cloudformation.UpdateStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateStackOutput, error) {
+	args := m.Called(ctx, params)
+	return &cloudformation.UpdateStackOutput{}, args.Error(1)
+}
+
+func newTaggedStackClient() *mockCloudFormationClient {
+	client := new(mockCloudFormationClient)
+	client.On("DescribeStacks", mock.Anything, mock.Anything).Return(&cloudformation.DescribeStacksOutput{
+		Stacks: []types.Stack{{
+			StackName:    aws.String("app"),
+			Capabilities: []types.Capability{types.CapabilityCapabilityIam},
+			Parameters:   []types.Parameter{{ParameterKey: aws.String("Env"), ParameterValue: aws.String("prod")}},
+			Tags:         []types.Tag{{Key: aws.String("Team"), Value: aws.String("web")}},
+		}},
+	}, nil)
+	return client
+}
+
+// Unit test for TagStack merging tags and keeping the previous template and parameters
+func TestTagStack(t *testing.T) {
+	client := newTaggedStackClient()
+	client.On("UpdateStack", mock.Anything, &cloudformation.UpdateStackInput{
+		StackName:           aws.String("app"),
+		UsePreviousTemplate: aws.Bool(true),
+		Parameters:          []types.Parameter{{ParameterKey: aws.String("Env"), UsePreviousValue: aws.Bool(true)}},
+		Capabilities:        []types.Capability{types.CapabilityCapabilityIam},
+		Tags: []types.Tag{
+			{Key: aws.String("Owner"), Value: aws.String("ops")},
+			{Key: aws.String("Team"), Value: aws.String("web")},
+		},
+	}).Return(nil, nil)
+
+	svc := &CloudFormationService{Client: client}
+	started, err := svc.TagStack(context.Background(), "app", map[string]string{"Owner": "ops"})
+	assert.NoError(t, err)
+	assert.True(t, started)
+	client.AssertExpectations(t)
+}
+
+// Unit test for UntagStack skipping the update when no tags change
+func TestUntagStackUnchanged(t *testing.T) {
+	client := newTaggedStackClient()
+
+	svc := &CloudFormationService{Client: client}
+	started, err := svc.UntagStack(context.Background(), "app", []string{"Owner"})
+	assert.NoError(t, err)
+	assert.False(t, started)
+	client.AssertNotCalled(t, "UpdateStack", mock.Anything, mock.Anything)
+}
+
+// Unit test for TagStack refusing a stack with an operation in progress
+func TestTagStackInProgress(t *testing.T) {
+	client := new(mockCloudFormationClient)
+	client.On("DescribeStacks", mock.Anything, mock.Anything).Return(&cloudformation.DescribeStacksOutput{
+		Stacks: []types.Stack{{StackName: aws.String("app"), StackStatus: types.StackStatusUpdateInProgress}},
+	}, nil)
+
+	svc := &CloudFormationService{Client: client}
+	started, err := svc.TagStack(context.Background(), "app", map[string]string{"Owner": "ops"})
+	assert.EqualError(t, err, "stack app is UPDATE_IN_PROGRESS. Wait for it to finish before changing its tags")
+	assert.False(t, started)
+	client.AssertNotCalled(t, "UpdateStack", mock.Anything, mock.Anything)
+}
+
+func TestIsFailedStackStatus(t *testing.T) {
+	tests := map[string]bool{
+		"CREATE_COMPLETE":          false,
+		"UPDATE_IN_PROGRESS":       false,
+		"CREATE_FAILED":            true,
+		"ROLLBACK_COMPLETE":        true,
+		"UPDATE_ROLLBACK_COMPLETE": true,
+		"delete_failed":            true,
+	}
+	for status, want := range tests {
+		assert.Equal(t, want, IsFailedStackStatus(status), status)
+	}
+}
@@ -0,0 +1,106 @@
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfsdk "github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// TagStack adds or overwrites tags on a stack. CloudFormation has no tagging API for stacks, so
+// the stack is updated with its previous template and parameters and the merged tags, which
+// also propagates the tags to the stack's resources. It returns true if an update was started,
+// which completes after TagStack returns, or false if the stack already has the tags.
+func (svc *CloudFormationService) TagStack(ctx context.Context, stackName string, tags map[string]string) (bool, error) {
+	return svc.updateStackTags(ctx, stackName, func(current map[string]string) {
+		maps.Copy(current, tags)
+	})
+}
+
+// UntagStack removes the tags with the given keys from a stack by updating the stack. It returns
+// true if an update was started, or false if the stack has none of the tags.
+func (svc *CloudFormationService) UntagStack(ctx context.Context, stackName string, keys []string) (bool, error) {
+	return svc.updateStackTags(ctx, stackName, func(current map[string]string) {
+		for _, key := range keys {
+			delete(current, key)
+		}
+	})
+}
+
+// GetStackTags returns the tags of a stack.
+func (svc *CloudFormationService) GetStackTags(ctx context.Context, stackName string) (map[string]string, error) {
+	stack, err := svc.describeStack(ctx, stackName)
+	if err != nil {
+		return nil, err
+	}
+	return stackTags(stack), nil
+}
+
+// describeStack returns a stack by name.
+func (svc *CloudFormationService) describeStack(ctx context.Context, stackName string) (types.Stack, error) {
+	output, err := svc.Client.DescribeStacks(ctx, &cfsdk.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return types.Stack{}, fmt.Errorf("describe stack: %w", err)
+	}
+	if len(output.Stacks) == 0 {
+		return types.Stack{}, fmt.Errorf("stack %s not found", stackName)
+	}
+	return output.Stacks[0], nil
+}
+
+// stackTags returns the tags of a stack as a map.
+func stackTags(stack types.Stack) map[string]string {
+	tags := make(map[string]string, len(stack.Tags))
+	for _, tag := range stack.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags
+}
+
+// updateStackTags applies the change to the stack's tags and starts an update of the stack. The
+// stack is left alone if the tags are unchanged, as CloudFormation rejects updates with no
+// changes, and stacks with an operation in progress are refused.
+func (svc *CloudFormationService) updateStackTags(ctx context.Context, stackName string, change func(map[string]string)) (bool, error) {
+	stack, err := svc.describeStack(ctx, stackName)
+	if err != nil {
+		return false, err
+	}
+	if status := string(stack.StackStatus); strings.HasSuffix(status, "_IN_PROGRESS") {
+		return false, fmt.Errorf("stack %s is %s. Wait for it to finish before changing its tags", stackName, status)
+	}
+
+	current := stackTags(stack)
+	updated := maps.Clone(current)
+	change(updated)
+	if maps.Equal(current, updated) {
+		return false, nil
+	}
+
+	tags := []types.Tag{}
+	for _, key := range slices.Sorted(maps.Keys(updated)) {
+		tags = append(tags, types.Tag{Key: aws.String(key), Value: aws.String(updated[key])})
+	}
+	var params []types.Parameter
+	for _, p := range stack.Parameters {
+		params = append(params, types.Parameter{ParameterKey: p.ParameterKey, UsePreviousValue: aws.Bool(true)})
+	}
+
+	_, err = svc.Client.UpdateStack(ctx, &cfsdk.UpdateStackInput{
+		StackName:           aws.String(stackName),
+		UsePreviousTemplate: aws.Bool(true),
+		Parameters:          params,
+		Capabilities:        stack.Capabilities,
+		Tags:                tags,
+	})
+	if err != nil {
+		return false, fmt.Errorf("update stack: %w", err)
+	}
+	return true, nil
+}
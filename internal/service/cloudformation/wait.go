@@ -14,6 +14,13 @@ func IsTerminalStackStatus(status string) bool {
 	return !strings.HasSuffix(strings.ToUpper(status), "_IN_PROGRESS")
 }
 
+// IsFailedStackStatus returns true if the CloudFormation stack status is a failure, such as
+// CREATE_FAILED or a completed rollback.
+func IsFailedStackStatus(status string) bool {
+	status = strings.ToUpper(status)
+	return strings.HasSuffix(status, "_FAILED") || strings.HasSuffix(status, "ROLLBACK_COMPLETE")
+}
+
 // GetStackStatus returns the current status of a CloudFormation stack.
 func (svc *CloudFormationService) GetStackStatus(ctx context.Context, stackName string) (string, error) {
 	output, err := svc.Client.DescribeStacks(ctx, &cfsdk.DescribeStacksInput{
@@ -0,0 +1,87 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an EC2ClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	EC2ClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client EC2ClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{EC2ClientAPI: client, cache: awsutil.NewResponseCache(cfg, "ec2")}
+}
+
+func (c *cachedClient) DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeInstances", params, func() (*ec2.DescribeInstancesOutput, error) {
+		return c.EC2ClientAPI.DescribeInstances(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeVolumes(ctx context.Context, params *ec2.DescribeVolumesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeVolumes", params, func() (*ec2.DescribeVolumesOutput, error) {
+		return c.EC2ClientAPI.DescribeVolumes(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeSecurityGroupRules(ctx context.Context, params *ec2.DescribeSecurityGroupRulesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupRulesOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeSecurityGroupRules", params, func() (*ec2.DescribeSecurityGroupRulesOutput, error) {
+		return c.EC2ClientAPI.DescribeSecurityGroupRules(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeSnapshots(ctx context.Context, params *ec2.DescribeSnapshotsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeSnapshots", params, func() (*ec2.DescribeSnapshotsOutput, error) {
+		return c.EC2ClientAPI.DescribeSnapshots(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeImages(ctx context.Context, params *ec2.DescribeImagesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeImages", params, func() (*ec2.DescribeImagesOutput, error) {
+		return c.EC2ClientAPI.DescribeImages(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeSecurityGroups(ctx context.Context, params *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeSecurityGroups", params, func() (*ec2.DescribeSecurityGroupsOutput, error) {
+		return c.EC2ClientAPI.DescribeSecurityGroups(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) RebootInstances(ctx context.Context, params *ec2.RebootInstancesInput, optFns ...func(*ec2.Options)) (*ec2.RebootInstancesOutput, error) {
+	defer c.cache.Invalidate()
+	return c.EC2ClientAPI.RebootInstances(ctx, params, optFns...)
+}
+
+func (c *cachedClient) StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	defer c.cache.Invalidate()
+	return c.EC2ClientAPI.StartInstances(ctx, params, optFns...)
+}
+
+func (c *cachedClient) StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	defer c.cache.Invalidate()
+	return c.EC2ClientAPI.StopInstances(ctx, params, optFns...)
+}
+
+func (c *cachedClient) TerminateInstances(ctx context.Context, params *ec2.TerminateInstancesInput, optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error) {
+	defer c.cache.Invalidate()
+	return c.EC2ClientAPI.TerminateInstances(ctx, params, optFns...)
+}
+
+func (c *cachedClient) CreateTags(ctx context.Context, params *ec2.CreateTagsInput, optFns ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	defer c.cache.Invalidate()
+	return c.EC2ClientAPI.CreateTags(ctx, params, optFns...)
+}
+
+func (c *cachedClient) DeleteTags(ctx context.Context, params *ec2.DeleteTagsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	defer c.cache.Invalidate()
+	return c.EC2ClientAPI.DeleteTags(ctx, params, optFns...)
+}
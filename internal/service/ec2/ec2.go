@@ -2,11 +2,13 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ec2/types"
 	"github.com/harleymckenzie/asc/internal/shared/awsutil"
@@ -24,6 +26,9 @@ type EC2ClientAPI interface {
 	StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
 	StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 	TerminateInstances(ctx context.Context, params *ec2.TerminateInstancesInput, optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error)
+	CreateTags(ctx context.Context, params *ec2.CreateTagsInput, optFns ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, params *ec2.DeleteTagsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+	DescribeTags(ctx context.Context, params *ec2.DescribeTagsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTagsOutput, error)
 	
 }
 
@@ -42,25 +47,24 @@ func NewEC2Service(ctx context.Context, profile string, region string) (*EC2Serv
 	if err != nil {
 		return nil, err
 	}
-	client := ec2.NewFromConfig(cfg.Config)
+	client := ec2.NewFromConfig(cfg.ServiceConfig("ec2"))
 
-	return &EC2Service{Client: client}, nil
+	return &EC2Service{Client: newCachedClient(client, cfg)}, nil
 }
 
-// GetInstances fetches EC2 instances and returns them directly.
+// GetInstances fetches all pages of EC2 instances and returns them directly.
 func (svc *EC2Service) GetInstances(ctx context.Context, input *ascTypes.GetInstancesInput) ([]types.Instance, error) {
-	output, err := svc.Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+	paginator := ec2.NewDescribeInstancesPaginator(svc.Client, &ec2.DescribeInstancesInput{
 		InstanceIds: input.InstanceIDs,
+		Filters:     input.Filters,
+	})
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeInstancesOutput) []types.Instance {
+		var instances []types.Instance
+		for _, reservation := range page.Reservations {
+			instances = append(instances, reservation.Instances...)
+		}
+		return instances
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var instances []types.Instance
-	for _, reservation := range output.Reservations {
-		instances = append(instances, reservation.Instances...)
-	}
-	return instances, nil
 }
 
 // getInstanceName gets the name of the instance from the tags.
@@ -85,9 +89,9 @@ func getSecurityGroups(securityGroups []types.GroupIdentifier) string {
 	return strings.Join(securityGroupsList, "\n")
 }
 
-// GetSecurityGroupRules gets the security group rules for the security group.
+// GetSecurityGroupRules gets all pages of security group rules for the security group.
 func (svc *EC2Service) GetSecurityGroupRules(ctx context.Context, input *ascTypes.GetSecurityGroupRulesInput) ([]types.SecurityGroupRule, error) {
-	output, err := svc.Client.DescribeSecurityGroupRules(ctx, &ec2.DescribeSecurityGroupRulesInput{
+	paginator := ec2.NewDescribeSecurityGroupRulesPaginator(svc.Client, &ec2.DescribeSecurityGroupRulesInput{
 		Filters: []types.Filter{
 			{
 				Name:   aws.String("group-id"),
@@ -95,32 +99,27 @@ func (svc *EC2Service) GetSecurityGroupRules(ctx context.Context, input *ascType
 			},
 		},
 	})
-	if err != nil {
-		return nil, err
-	}
-	return output.SecurityGroupRules, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeSecurityGroupRulesOutput) []types.SecurityGroupRule {
+		return page.SecurityGroupRules
+	})
 }
 
 // RestartInstance restarts an instance.
 func (svc *EC2Service) RestartInstance(ctx context.Context, input *ascTypes.RestartInstanceInput) error {
 	_, err := svc.Client.RebootInstances(ctx, &ec2.RebootInstancesInput{
 		InstanceIds: []string{input.InstanceID},
+		DryRun:      &input.DryRun,
 	})
-	if err != nil {
-		return err
-	}
-	return nil
+	return dryRunResult(err)
 }
 
 // StartInstance starts an instance.
 func (svc *EC2Service) StartInstance(ctx context.Context, input *ascTypes.StartInstanceInput) error {
 	_, err := svc.Client.StartInstances(ctx, &ec2.StartInstancesInput{
 		InstanceIds: []string{input.InstanceID},
+		DryRun:      &input.DryRun,
 	})
-	if err != nil {
-		return err
-	}
-	return nil
+	return dryRunResult(err)
 }
 
 // StopInstance stops an instance.
@@ -128,92 +127,85 @@ func (svc *EC2Service) StopInstance(ctx context.Context, input *ascTypes.StopIns
 	_, err := svc.Client.StopInstances(ctx, &ec2.StopInstancesInput{
 		InstanceIds: []string{input.InstanceID},
 		Force:       &input.Force,
+		DryRun:      &input.DryRun,
 	})
-	if err != nil {
-		return err
-	}
-	return nil
+	return dryRunResult(err)
 }
 
 // TerminateInstance terminates an instance.
 func (svc *EC2Service) TerminateInstance(ctx context.Context, input *ascTypes.TerminateInstanceInput) error {
 	_, err := svc.Client.TerminateInstances(ctx, &ec2.TerminateInstancesInput{
 		InstanceIds: []string{input.InstanceID},
+		DryRun:      &input.DryRun,
 	})
-	if err != nil {
-		return err
+	return dryRunResult(err)
+}
+
+// dryRunResult returns nil for the DryRunOperation error that EC2 returns when a request with
+// DryRun set would have succeeded. Other errors, such as UnauthorizedOperation, are returned.
+func dryRunResult(err error) error {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() == "DryRunOperation" {
+		return nil
 	}
-	return nil
+	return err
 }
 
-// GetVolumes fetches EC2 volumes and returns them directly.
+// GetVolumes fetches all pages of EC2 volumes and returns them directly.
 func (svc *EC2Service) GetVolumes(ctx context.Context, input *ascTypes.GetVolumesInput) ([]types.Volume, error) {
-	output, err := svc.Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+	paginator := ec2.NewDescribeVolumesPaginator(svc.Client, &ec2.DescribeVolumesInput{
 		VolumeIds: input.VolumeIDs,
+		Filters:   input.Filters,
+	})
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeVolumesOutput) []types.Volume {
+		return page.Volumes
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	volumes := append([]types.Volume{}, output.Volumes...)
-	return volumes, nil
 }
 
-// GetSnapshots fetches EC2 snapshots and returns them directly.
+// GetSnapshots fetches all pages of EC2 snapshots and returns them directly.
 func (svc *EC2Service) GetSnapshots(ctx context.Context, input *ascTypes.GetSnapshotsInput) ([]types.Snapshot, error) {
-	output, err := svc.Client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+	paginator := ec2.NewDescribeSnapshotsPaginator(svc.Client, &ec2.DescribeSnapshotsInput{
 		SnapshotIds: input.SnapshotIDs,
 		Filters:     input.Filters,
 		OwnerIds:    input.OwnerIds,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	snapshots := append([]types.Snapshot{}, output.Snapshots...)
-	return snapshots, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeSnapshotsOutput) []types.Snapshot {
+		return page.Snapshots
+	})
 }
 
-// GetImages fetches EC2 images and returns them directly.
+// GetImages fetches all pages of EC2 images and returns them directly.
 func (svc *EC2Service) GetImages(ctx context.Context, input *ascTypes.GetImagesInput) ([]types.Image, error) {
-	output, err := svc.Client.DescribeImages(ctx, &ec2.DescribeImagesInput{
+	paginator := ec2.NewDescribeImagesPaginator(svc.Client, &ec2.DescribeImagesInput{
 		ImageIds: input.ImageIds,
-		Filters: input.Filters,
-		Owners:  input.Owners,
+		Filters:  input.Filters,
+		Owners:   input.Owners,
+	})
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeImagesOutput) []types.Image {
+		return page.Images
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	images := append([]types.Image{}, output.Images...)
-	return images, nil
 }
 
-// GetSecurityGroups fetches EC2 security groups and returns them directly.
+// GetSecurityGroups fetches all pages of EC2 security groups and returns them directly.
 func (svc *EC2Service) GetSecurityGroups(ctx context.Context, input *ascTypes.GetSecurityGroupsInput) ([]types.SecurityGroup, error) {
-	output, err := svc.Client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(svc.Client, &ec2.DescribeSecurityGroupsInput{
 		GroupIds: input.GroupIDs,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	groups := append([]types.SecurityGroup{}, output.SecurityGroups...)
-	return groups, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeSecurityGroupsOutput) []types.SecurityGroup {
+		return page.SecurityGroups
+	})
 }
 
-// GetImagesWithFilters fetches EC2 images with custom filters and owners.
+// GetImagesWithFilters fetches all pages of EC2 images with custom filters and owners.
 func (svc *EC2Service) GetImagesWithFilters(ctx context.Context, input *ascTypes.GetImagesInput, filters []types.Filter, owners []string) ([]types.Image, error) {
-	output, err := svc.Client.DescribeImages(ctx, &ec2.DescribeImagesInput{
+	paginator := ec2.NewDescribeImagesPaginator(svc.Client, &ec2.DescribeImagesInput{
 		// ImageIds: input.ImageIds,
 		Filters: filters,
 		Owners:  owners,
 	})
-	if err != nil {
-		return nil, err
-	}
-	images := append([]types.Image{}, output.Images...)
-	return images, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeImagesOutput) []types.Image {
+		return page.Images
+	})
 }
 
 // FilterSecurityGroupRules will filter the rules by inbound or outbound
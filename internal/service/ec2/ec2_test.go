@@ -5,12 +5,15 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ec2/types"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 )
 
 // MockEC2Client is a mock implementation of EC2ClientAPI for unit tests.
@@ -63,6 +66,33 @@ func (m *MockEC2Client) TerminateInstances(
 	return &ec2.TerminateInstancesOutput{}, args.Error(1)
 }
 
+func (m *MockEC2Client) CreateTags(
+	ctx context.Context,
+	params *ec2.CreateTagsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.CreateTagsOutput, error) {
+	args := m.Called(ctx, params)
+	return &ec2.CreateTagsOutput{}, args.Error(1)
+}
+
+func (m *MockEC2Client) DeleteTags(
+	ctx context.Context,
+	params *ec2.DeleteTagsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DeleteTagsOutput, error) {
+	args := m.Called(ctx, params)
+	return &ec2.DeleteTagsOutput{}, args.Error(1)
+}
+
+func (m *MockEC2Client) DescribeTags(
+	ctx context.Context,
+	params *ec2.DescribeTagsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribeTagsOutput, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(*ec2.DescribeTagsOutput), args.Error(1)
+}
+
 func (m *MockEC2Client) DescribeImages(
 	ctx context.Context,
 	params *ec2.DescribeImagesInput,
@@ -126,6 +156,53 @@ func TestGetInstances(t *testing.T) {
 	assert.Equal(t, "i-123", *instances[0].InstanceId)
 }
 
+// Unit test for GetInstances reading every page
+func TestGetInstancesPaginated(t *testing.T) {
+	mockClient := new(MockEC2Client)
+	firstPage := &ec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{Instances: []types.Instance{{InstanceId: aws.String("i-1")}}},
+		},
+		NextToken: aws.String("page-2"),
+	}
+	secondPage := &ec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{Instances: []types.Instance{{InstanceId: aws.String("i-2")}}},
+		},
+	}
+	mockClient.On("DescribeInstances", mock.Anything, mock.MatchedBy(func(in *ec2.DescribeInstancesInput) bool {
+		return in.NextToken == nil
+	})).Return(firstPage, nil)
+	mockClient.On("DescribeInstances", mock.Anything, mock.MatchedBy(func(in *ec2.DescribeInstancesInput) bool {
+		return aws.ToString(in.NextToken) == "page-2"
+	})).Return(secondPage, nil)
+
+	svc := &EC2Service{Client: mockClient}
+	instances, err := svc.GetInstances(context.Background(), &ascTypes.GetInstancesInput{})
+	assert.NoError(t, err)
+	assert.Len(t, instances, 2)
+	assert.Equal(t, "i-2", *instances[1].InstanceId)
+}
+
+// Unit test for GetInstances passing list filters to DescribeInstances
+func TestGetInstancesServerFilters(t *testing.T) {
+	filters, err := tablewriter.ParseFilters([]string{"state=running", "Tag:Env=prod", `"Instance Type"~^m5`, "Name=web"})
+	assert.NoError(t, err)
+
+	mockClient := new(MockEC2Client)
+	mockClient.On("DescribeInstances", mock.Anything, mock.MatchedBy(func(in *ec2.DescribeInstancesInput) bool {
+		return assert.ObjectsAreEqual([]types.Filter{
+			{Name: aws.String("instance-state-name"), Values: []string{"running"}},
+			{Name: aws.String("tag:Env"), Values: []string{"prod"}},
+		}, in.Filters)
+	})).Return(&ec2.DescribeInstancesOutput{}, nil)
+
+	svc := &EC2Service{Client: mockClient}
+	_, err = svc.GetInstances(context.Background(), &ascTypes.GetInstancesInput{Filters: InstanceFilters(filters)})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
 // Unit test for StartInstance
 func TestStartInstance(t *testing.T) {
 	mockClient := new(MockEC2Client)
@@ -150,6 +227,22 @@ func TestStopInstance(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// Unit test for StopInstance with DryRun set
+func TestStopInstanceDryRun(t *testing.T) {
+	mockClient := new(MockEC2Client)
+	input := &ascTypes.StopInstanceInput{InstanceID: "i-123", DryRun: true}
+	mockClient.On("StopInstances", mock.Anything, mock.MatchedBy(func(in *ec2.StopInstancesInput) bool {
+		return aws.ToBool(in.DryRun)
+	})).Return(&ec2.StopInstancesOutput{}, &smithy.GenericAPIError{Code: "DryRunOperation"}).Once()
+	mockClient.On("StopInstances", mock.Anything, mock.Anything).
+		Return(&ec2.StopInstancesOutput{}, &smithy.GenericAPIError{Code: "UnauthorizedOperation"}).Once()
+
+	svc := &EC2Service{Client: mockClient}
+	assert.NoError(t, svc.StopInstance(context.Background(), input), "DryRunOperation means the request would succeed")
+	assert.Error(t, svc.StopInstance(context.Background(), input), "other errors are returned")
+	mockClient.AssertExpectations(t)
+}
+
 // Unit test for RestartInstance
 func TestRestartInstance(t *testing.T) {
 	mockClient := new(MockEC2Client)
@@ -174,6 +267,56 @@ func TestTerminateInstance(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// Unit test for TagResource sending tags in key order
+func TestTagResource(t *testing.T) {
+	mockClient := new(MockEC2Client)
+	mockClient.On("CreateTags", mock.Anything, &ec2.CreateTagsInput{
+		Resources: []string{"vol-123"},
+		Tags: []types.Tag{
+			{Key: aws.String("Env"), Value: aws.String("prod")},
+			{Key: aws.String("Owner"), Value: aws.String("")},
+		},
+	}).Return(&ec2.CreateTagsOutput{}, nil)
+
+	svc := &EC2Service{Client: mockClient}
+	err := svc.TagResource(context.Background(), "vol-123", map[string]string{"Owner": "", "Env": "prod"})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+// Unit test for UntagResource
+func TestUntagResource(t *testing.T) {
+	mockClient := new(MockEC2Client)
+	mockClient.On("DeleteTags", mock.Anything, &ec2.DeleteTagsInput{
+		Resources: []string{"i-123"},
+		Tags:      []types.Tag{{Key: aws.String("Env")}},
+	}).Return(&ec2.DeleteTagsOutput{}, nil)
+
+	svc := &EC2Service{Client: mockClient}
+	err := svc.UntagResource(context.Background(), "i-123", []string{"Env"})
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+// Unit test for GetTags following pages of tags
+func TestGetTags(t *testing.T) {
+	filters := []types.Filter{{Name: aws.String("resource-id"), Values: []string{"i-123"}}}
+	mockClient := new(MockEC2Client)
+	mockClient.On("DescribeTags", mock.Anything, &ec2.DescribeTagsInput{Filters: filters}).Return(&ec2.DescribeTagsOutput{
+		Tags:      []types.TagDescription{{Key: aws.String("Env"), Value: aws.String("prod")}},
+		NextToken: aws.String("page-2"),
+	}, nil)
+	mockClient.On("DescribeTags", mock.Anything, &ec2.DescribeTagsInput{Filters: filters, NextToken: aws.String("page-2")}).Return(&ec2.DescribeTagsOutput{
+		Tags: []types.TagDescription{{Key: aws.String("Owner"), Value: aws.String("")}},
+	}, nil)
+
+	svc := &EC2Service{Client: mockClient}
+	tags, err := svc.GetTags(context.Background(), "i-123")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"Env": "prod", "Owner": ""}, tags)
+	mockClient.AssertExpectations(t)
+}
+
 // Integration test for NewEC2Service (skipped unless EC2_INTEGRATION=1)
 func TestNewEC2Service_Integration(t *testing.T) {
 	if os.Getenv("INTEGRATION") != "1" {
@@ -0,0 +1,76 @@
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+)
+
+// instanceFilterNames maps instance fields to the DescribeInstances filter names.
+var instanceFilterNames = map[string]string{
+	"Instance ID":       "instance-id",
+	"State":             "instance-state-name",
+	"Instance Type":     "instance-type",
+	"AMI ID":            "image-id",
+	"Public IP":         "ip-address",
+	"Private IP":        "private-ip-address",
+	"Subnet ID":         "subnet-id",
+	"VPC ID":            "vpc-id",
+	"Availability Zone": "availability-zone",
+	"Key Name":          "key-name",
+}
+
+// volumeFilterNames maps volume fields to the DescribeVolumes filter names.
+var volumeFilterNames = map[string]string{
+	"Volume ID":         "volume-id",
+	"Type":              "volume-type",
+	"State":             "status",
+	"Snapshot ID":       "snapshot-id",
+	"Availability Zone": "availability-zone",
+	"Instance ID":       "attachment.instance-id",
+}
+
+// InstanceFilters returns the server-side DescribeInstances filters for the list filters that
+// the API supports. The list filters are still applied to the results.
+func InstanceFilters(filters []tablewriter.Filter) []types.Filter {
+	return serverFilters(filters, instanceFilterNames)
+}
+
+// VolumeFilters returns the server-side DescribeVolumes filters for the list filters that the
+// API supports. The list filters are still applied to the results.
+func VolumeFilters(filters []tablewriter.Filter) []types.Filter {
+	return serverFilters(filters, volumeFilterNames)
+}
+
+// serverFilters converts the exact match filters on supported fields and tags into EC2 filters.
+// Other operators can't be expressed as EC2 filters and are only applied client-side.
+func serverFilters(filters []tablewriter.Filter, names map[string]string) []types.Filter {
+	var ec2Filters []types.Filter
+	for _, filter := range filters {
+		if filter.Operator != tablewriter.OpEqual || filter.Value == "" {
+			continue
+		}
+
+		var name string
+		if filter.Tag {
+			name = "tag:" + filter.Name
+		} else {
+			for field, filterName := range names {
+				if strings.EqualFold(field, filter.Name) {
+					name = filterName
+					break
+				}
+			}
+		}
+		if name == "" {
+			continue
+		}
+		ec2Filters = append(ec2Filters, types.Filter{
+			Name:   aws.String(name),
+			Values: []string{filter.Value},
+		})
+	}
+	return ec2Filters
+}
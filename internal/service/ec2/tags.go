@@ -0,0 +1,58 @@
+package ec2
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// TagResource adds or overwrites tags on an EC2 resource, such as an instance, volume or VPC.
+func (svc *EC2Service) TagResource(ctx context.Context, id string, tags map[string]string) error {
+	var ec2Tags []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		ec2Tags = append(ec2Tags, types.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	_, err := svc.Client.CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: []string{id},
+		Tags:      ec2Tags,
+	})
+	return err
+}
+
+// UntagResource removes the tags with the given keys from an EC2 resource.
+func (svc *EC2Service) UntagResource(ctx context.Context, id string, keys []string) error {
+	var ec2Tags []types.Tag
+	for _, key := range keys {
+		ec2Tags = append(ec2Tags, types.Tag{Key: aws.String(key)})
+	}
+	_, err := svc.Client.DeleteTags(ctx, &ec2.DeleteTagsInput{
+		Resources: []string{id},
+		Tags:      ec2Tags,
+	})
+	return err
+}
+
+// GetTags returns the tags of an EC2 resource.
+func (svc *EC2Service) GetTags(ctx context.Context, id string) (map[string]string, error) {
+	tags := map[string]string{}
+	input := &ec2.DescribeTagsInput{
+		Filters: []types.Filter{{Name: aws.String("resource-id"), Values: []string{id}}},
+	}
+	for {
+		output, err := svc.Client.DescribeTags(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range output.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		if output.NextToken == nil {
+			return tags, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
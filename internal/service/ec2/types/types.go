@@ -6,12 +6,18 @@ type GetInstancesInput struct {
 
 	// The IDs of the instances to get
 	InstanceIDs []string
+
+	// Filters to apply to the instances
+	Filters []types.Filter
 }
 
 type GetVolumesInput struct {
 
 	// The IDs of the volumes to get
 	VolumeIDs []string
+
+	// Filters to apply to the volumes
+	Filters []types.Filter
 }
 
 type GetImagesInput struct {
@@ -49,12 +55,18 @@ type RestartInstanceInput struct {
 
 	// The ID of the instance to restart
 	InstanceID string
+
+	// Whether to only check permissions, without restarting the instance
+	DryRun bool
 }
 
 type StartInstanceInput struct {
 
 	// The ID of the instance to start
 	InstanceID string
+
+	// Whether to only check permissions, without starting the instance
+	DryRun bool
 }
 
 type StopInstanceInput struct {
@@ -64,12 +76,18 @@ type StopInstanceInput struct {
 
 	// Whether to force stop the instance
 	Force bool
+
+	// Whether to only check permissions, without stopping the instance
+	DryRun bool
 }
 
 type TerminateInstanceInput struct {
 
 	// The ID of the instance to terminate
 	InstanceID string
+
+	// Whether to only check permissions, without terminating the instance
+	DryRun bool
 }
 
 type GetSecurityGroupsInput struct {
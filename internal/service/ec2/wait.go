@@ -53,6 +53,27 @@ func IsTerminalImageState(status string) bool {
 	return imageTerminalStates[strings.ToLower(status)]
 }
 
+// IsFinalInstanceState returns true if the EC2 instance is shutting down or terminated, and so
+// can no longer reach any state other than terminated.
+func IsFinalInstanceState(status string) bool {
+	return strings.EqualFold(status, "shutting-down") || strings.EqualFold(status, "terminated")
+}
+
+// IsFailedVolumeState returns true if the EBS volume state is a failure.
+func IsFailedVolumeState(status string) bool {
+	return strings.EqualFold(status, "error")
+}
+
+// IsFailedSnapshotState returns true if the EBS snapshot state is a failure.
+func IsFailedSnapshotState(status string) bool {
+	return strings.EqualFold(status, "error")
+}
+
+// IsFailedImageState returns true if the AMI state is a failure.
+func IsFailedImageState(status string) bool {
+	return strings.EqualFold(status, "failed")
+}
+
 // GetInstanceStatus returns the current state of an EC2 instance.
 func (svc *EC2Service) GetInstanceStatus(ctx context.Context, instanceID string) (string, error) {
 	output, err := svc.Client.DescribeInstances(ctx, &ec2sdk.DescribeInstancesInput{
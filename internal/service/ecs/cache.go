@@ -0,0 +1,85 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an ECSClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	ECSClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client ECSClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{ECSClientAPI: client, cache: awsutil.NewResponseCache(cfg, "ecs")}
+}
+
+func (c *cachedClient) ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error) {
+	return awsutil.Cached(c.cache, "ListClusters", params, func() (*ecs.ListClustersOutput, error) {
+		return c.ECSClientAPI.ListClusters(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeClusters(ctx context.Context, params *ecs.DescribeClustersInput, optFns ...func(*ecs.Options)) (*ecs.DescribeClustersOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeClusters", params, func() (*ecs.DescribeClustersOutput, error) {
+		return c.ECSClientAPI.DescribeClusters(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListServices(ctx context.Context, params *ecs.ListServicesInput, optFns ...func(*ecs.Options)) (*ecs.ListServicesOutput, error) {
+	return awsutil.Cached(c.cache, "ListServices", params, func() (*ecs.ListServicesOutput, error) {
+		return c.ECSClientAPI.ListServices(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeServices(ctx context.Context, params *ecs.DescribeServicesInput, optFns ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeServices", params, func() (*ecs.DescribeServicesOutput, error) {
+		return c.ECSClientAPI.DescribeServices(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListTasks(ctx context.Context, params *ecs.ListTasksInput, optFns ...func(*ecs.Options)) (*ecs.ListTasksOutput, error) {
+	return awsutil.Cached(c.cache, "ListTasks", params, func() (*ecs.ListTasksOutput, error) {
+		return c.ECSClientAPI.ListTasks(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeTasks(ctx context.Context, params *ecs.DescribeTasksInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeTasks", params, func() (*ecs.DescribeTasksOutput, error) {
+		return c.ECSClientAPI.DescribeTasks(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListTaskDefinitionFamilies(ctx context.Context, params *ecs.ListTaskDefinitionFamiliesInput, optFns ...func(*ecs.Options)) (*ecs.ListTaskDefinitionFamiliesOutput, error) {
+	return awsutil.Cached(c.cache, "ListTaskDefinitionFamilies", params, func() (*ecs.ListTaskDefinitionFamiliesOutput, error) {
+		return c.ECSClientAPI.ListTaskDefinitionFamilies(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListTaskDefinitions(ctx context.Context, params *ecs.ListTaskDefinitionsInput, optFns ...func(*ecs.Options)) (*ecs.ListTaskDefinitionsOutput, error) {
+	return awsutil.Cached(c.cache, "ListTaskDefinitions", params, func() (*ecs.ListTaskDefinitionsOutput, error) {
+		return c.ECSClientAPI.ListTaskDefinitions(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeTaskDefinition(ctx context.Context, params *ecs.DescribeTaskDefinitionInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTaskDefinitionOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeTaskDefinition", params, func() (*ecs.DescribeTaskDefinitionOutput, error) {
+		return c.ECSClientAPI.DescribeTaskDefinition(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) TagResource(ctx context.Context, params *ecs.TagResourceInput, optFns ...func(*ecs.Options)) (*ecs.TagResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.ECSClientAPI.TagResource(ctx, params, optFns...)
+}
+
+func (c *cachedClient) UntagResource(ctx context.Context, params *ecs.UntagResourceInput, optFns ...func(*ecs.Options)) (*ecs.UntagResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.ECSClientAPI.UntagResource(ctx, params, optFns...)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"slices"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	ascTypes "github.com/harleymckenzie/asc/internal/service/ecs/types"
@@ -22,11 +24,17 @@ type ECSClientAPI interface {
 	ListTaskDefinitionFamilies(context.Context, *ecs.ListTaskDefinitionFamiliesInput, ...func(*ecs.Options)) (*ecs.ListTaskDefinitionFamiliesOutput, error)
 	ListTaskDefinitions(context.Context, *ecs.ListTaskDefinitionsInput, ...func(*ecs.Options)) (*ecs.ListTaskDefinitionsOutput, error)
 	DescribeTaskDefinition(context.Context, *ecs.DescribeTaskDefinitionInput, ...func(*ecs.Options)) (*ecs.DescribeTaskDefinitionOutput, error)
+	TagResource(context.Context, *ecs.TagResourceInput, ...func(*ecs.Options)) (*ecs.TagResourceOutput, error)
+	UntagResource(context.Context, *ecs.UntagResourceInput, ...func(*ecs.Options)) (*ecs.UntagResourceOutput, error)
+	ListTagsForResource(context.Context, *ecs.ListTagsForResourceInput, ...func(*ecs.Options)) (*ecs.ListTagsForResourceOutput, error)
 }
 
 // ECSService is the service for the ECS client.
 type ECSService struct {
 	Client ECSClientAPI
+	// Concurrency is the maximum number of calls made at a time when listing and describing
+	// the clusters, services and tasks of several clusters. 0 uses awsutil.DefaultConcurrency.
+	Concurrency int
 }
 
 // NewECSService creates a new ECS service.
@@ -36,94 +44,102 @@ func NewECSService(ctx context.Context, profile string, region string) (*ECSServ
 		return nil, err
 	}
 
-	client := ecs.NewFromConfig(cfg.Config)
-	return &ECSService{Client: client}, nil
+	client := ecs.NewFromConfig(cfg.ServiceConfig("ecs"))
+	return &ECSService{Client: newCachedClient(client, cfg)}, nil
 }
 
+// Maximum number of resources accepted by each ECS describe call.
+const (
+	maxDescribeClusters = 100
+	maxDescribeServices = 10
+	maxDescribeTasks    = 100
+)
+
 // ListClusters lists all ECS cluster ARNs.
 func (svc *ECSService) ListClusters(ctx context.Context, input *ascTypes.ListClustersInput) ([]string, error) {
-	var allARNs []string
-	var nextToken *string
+	paginator := ecs.NewListClustersPaginator(svc.Client, &ecs.ListClustersInput{})
+	return awsutil.CollectPages(ctx, paginator, func(page *ecs.ListClustersOutput) []string {
+		return page.ClusterArns
+	})
+}
 
-	for {
-		output, err := svc.Client.ListClusters(ctx, &ecs.ListClustersInput{
-			NextToken: nextToken,
+// DescribeClusters describes the specified ECS clusters, in concurrent batches of up to 100.
+func (svc *ECSService) DescribeClusters(ctx context.Context, input *ascTypes.DescribeClustersInput) ([]types.Cluster, error) {
+	batches := slices.Collect(slices.Chunk(input.ClusterARNs, maxDescribeClusters))
+	results, err := awsutil.ParallelMap(ctx, svc.Concurrency, batches, func(ctx context.Context, batch []string) ([]types.Cluster, error) {
+		output, err := svc.Client.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: batch,
+			Include:  []types.ClusterField{types.ClusterFieldTags, types.ClusterFieldStatistics},
 		})
 		if err != nil {
 			return nil, err
 		}
-		allARNs = append(allARNs, output.ClusterArns...)
-		if output.NextToken == nil {
-			break
-		}
-		nextToken = output.NextToken
-	}
-
-	return allARNs, nil
-}
-
-// DescribeClusters describes the specified ECS clusters.
-func (svc *ECSService) DescribeClusters(ctx context.Context, input *ascTypes.DescribeClustersInput) ([]types.Cluster, error) {
-	output, err := svc.Client.DescribeClusters(ctx, &ecs.DescribeClustersInput{
-		Clusters: input.ClusterARNs,
-		Include:  []types.ClusterField{types.ClusterFieldTags, types.ClusterFieldStatistics},
+		return output.Clusters, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return output.Clusters, nil
+	return slices.Concat(results...), nil
 }
 
 // ListServices lists all ECS services in the specified cluster.
 func (svc *ECSService) ListServices(ctx context.Context, input *ascTypes.ListServicesInput) ([]string, error) {
-	var allARNs []string
-	var nextToken *string
-
-	params := &ecs.ListServicesInput{
-		NextToken: nextToken,
-	}
+	params := &ecs.ListServicesInput{}
 	if input.Cluster != "" {
 		params.Cluster = &input.Cluster
 	}
 
-	for {
-		output, err := svc.Client.ListServices(ctx, params)
-		if err != nil {
-			return nil, err
-		}
-		allARNs = append(allARNs, output.ServiceArns...)
-		if output.NextToken == nil {
-			break
-		}
-		params.NextToken = output.NextToken
-	}
-
-	return allARNs, nil
+	paginator := ecs.NewListServicesPaginator(svc.Client, params)
+	return awsutil.CollectPages(ctx, paginator, func(page *ecs.ListServicesOutput) []string {
+		return page.ServiceArns
+	})
 }
 
-// DescribeServices describes the specified ECS services.
+// DescribeServices describes the specified ECS services, in concurrent batches of up to 10.
 func (svc *ECSService) DescribeServices(ctx context.Context, input *ascTypes.DescribeServicesInput) ([]types.Service, error) {
-	output, err := svc.Client.DescribeServices(ctx, &ecs.DescribeServicesInput{
-		Cluster:  &input.Cluster,
-		Services: input.Services,
-		Include:  []types.ServiceField{types.ServiceFieldTags},
+	return svc.describeServiceBatches(ctx, serviceBatches(input.Cluster, input.Services))
+}
+
+// describeBatch is a batch of services or tasks in a cluster, described in a single call.
+type describeBatch struct {
+	cluster string
+	arns    []string
+}
+
+// serviceBatches splits the services of a cluster into batches for DescribeServices.
+func serviceBatches(cluster string, arns []string) []describeBatch {
+	var batches []describeBatch
+	for batch := range slices.Chunk(arns, maxDescribeServices) {
+		batches = append(batches, describeBatch{cluster: cluster, arns: batch})
+	}
+	return batches
+}
+
+// describeServiceBatches describes batches of services concurrently, returning the services in
+// the order of the batches.
+func (svc *ECSService) describeServiceBatches(ctx context.Context, batches []describeBatch) ([]types.Service, error) {
+	results, err := awsutil.ParallelMap(ctx, svc.Concurrency, batches, func(ctx context.Context, batch describeBatch) ([]types.Service, error) {
+		output, err := svc.Client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  &batch.cluster,
+			Services: batch.arns,
+			Include:  []types.ServiceField{types.ServiceFieldTags},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe services in cluster %s: %w", ShortARN(batch.cluster), err)
+		}
+		return output.Services, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return output.Services, nil
+	return slices.Concat(results...), nil
 }
 
 // ListTasks lists all ECS tasks in the specified cluster.
 func (svc *ECSService) ListTasks(ctx context.Context, input *ascTypes.ListTasksInput) ([]string, error) {
-	var allARNs []string
-	var nextToken *string
-
-	params := &ecs.ListTasksInput{
-		NextToken: nextToken,
-	}
+	params := &ecs.ListTasksInput{}
 	if input.Cluster != "" {
 		params.Cluster = &input.Cluster
 	}
@@ -131,79 +147,65 @@ func (svc *ECSService) ListTasks(ctx context.Context, input *ascTypes.ListTasksI
 		params.ServiceName = &input.ServiceName
 	}
 
-	for {
-		output, err := svc.Client.ListTasks(ctx, params)
-		if err != nil {
-			return nil, err
-		}
-		allARNs = append(allARNs, output.TaskArns...)
-		if output.NextToken == nil {
-			break
-		}
-		params.NextToken = output.NextToken
-	}
-
-	return allARNs, nil
+	paginator := ecs.NewListTasksPaginator(svc.Client, params)
+	return awsutil.CollectPages(ctx, paginator, func(page *ecs.ListTasksOutput) []string {
+		return page.TaskArns
+	})
 }
 
-// DescribeTasks describes the specified ECS tasks.
+// DescribeTasks describes the specified ECS tasks, in concurrent batches of up to 100.
 func (svc *ECSService) DescribeTasks(ctx context.Context, input *ascTypes.DescribeTasksInput) ([]types.Task, error) {
-	output, err := svc.Client.DescribeTasks(ctx, &ecs.DescribeTasksInput{
-		Cluster: &input.Cluster,
-		Tasks:   input.Tasks,
-		Include: []types.TaskField{types.TaskFieldTags},
+	return svc.describeTaskBatches(ctx, taskBatches(input.Cluster, input.Tasks))
+}
+
+// taskBatches splits the tasks of a cluster into batches for DescribeTasks.
+func taskBatches(cluster string, arns []string) []describeBatch {
+	var batches []describeBatch
+	for batch := range slices.Chunk(arns, maxDescribeTasks) {
+		batches = append(batches, describeBatch{cluster: cluster, arns: batch})
+	}
+	return batches
+}
+
+// describeTaskBatches describes batches of tasks concurrently, returning the tasks in the order
+// of the batches.
+func (svc *ECSService) describeTaskBatches(ctx context.Context, batches []describeBatch) ([]types.Task, error) {
+	results, err := awsutil.ParallelMap(ctx, svc.Concurrency, batches, func(ctx context.Context, batch describeBatch) ([]types.Task, error) {
+		output, err := svc.Client.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: &batch.cluster,
+			Tasks:   batch.arns,
+			Include: []types.TaskField{types.TaskFieldTags},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tasks in cluster %s: %w", ShortARN(batch.cluster), err)
+		}
+		return output.Tasks, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return output.Tasks, nil
+	return slices.Concat(results...), nil
 }
 
 // ListTaskDefinitionFamilies lists all ECS task definition families.
 func (svc *ECSService) ListTaskDefinitionFamilies(ctx context.Context, input *ascTypes.ListTaskDefinitionFamiliesInput) ([]string, error) {
-	var allFamilies []string
-	var nextToken *string
-
-	for {
-		output, err := svc.Client.ListTaskDefinitionFamilies(ctx, &ecs.ListTaskDefinitionFamiliesInput{
-			NextToken: nextToken,
-			Status:    types.TaskDefinitionFamilyStatusActive,
-		})
-		if err != nil {
-			return nil, err
-		}
-		allFamilies = append(allFamilies, output.Families...)
-		if output.NextToken == nil {
-			break
-		}
-		nextToken = output.NextToken
-	}
-
-	return allFamilies, nil
+	paginator := ecs.NewListTaskDefinitionFamiliesPaginator(svc.Client, &ecs.ListTaskDefinitionFamiliesInput{
+		Status: types.TaskDefinitionFamilyStatusActive,
+	})
+	return awsutil.CollectPages(ctx, paginator, func(page *ecs.ListTaskDefinitionFamiliesOutput) []string {
+		return page.Families
+	})
 }
 
 // ListTaskDefinitionRevisions lists all revisions for a task definition family.
 func (svc *ECSService) ListTaskDefinitionRevisions(ctx context.Context, input *ascTypes.ListTaskDefinitionRevisionsInput) ([]string, error) {
-	var allARNs []string
-	var nextToken *string
-
-	for {
-		output, err := svc.Client.ListTaskDefinitions(ctx, &ecs.ListTaskDefinitionsInput{
-			FamilyPrefix: &input.FamilyName,
-			NextToken:    nextToken,
-		})
-		if err != nil {
-			return nil, err
-		}
-		allARNs = append(allARNs, output.TaskDefinitionArns...)
-		if output.NextToken == nil {
-			break
-		}
-		nextToken = output.NextToken
-	}
-
-	return allARNs, nil
+	paginator := ecs.NewListTaskDefinitionsPaginator(svc.Client, &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: &input.FamilyName,
+	})
+	return awsutil.CollectPages(ctx, paginator, func(page *ecs.ListTaskDefinitionsOutput) []string {
+		return page.TaskDefinitionArns
+	})
 }
 
 // DescribeTaskDefinition describes a task definition.
@@ -233,28 +235,38 @@ func (svc *ECSService) GetAllClusters(ctx context.Context) ([]types.Cluster, err
 	return svc.DescribeClusters(ctx, &ascTypes.DescribeClustersInput{ClusterARNs: arns})
 }
 
-// GetAllServices lists and describes all services, optionally filtered by cluster.
+// GetAllServices lists and describes all services, optionally filtered by cluster. Without a
+// cluster, the services of every cluster are listed and described concurrently, and returned in
+// the order of the clusters.
 func (svc *ECSService) GetAllServices(ctx context.Context, cluster string) ([]types.Service, error) {
 	if cluster != "" {
 		return svc.getServicesForCluster(ctx, cluster)
 	}
 
-	// Get all clusters first, then get services for each
+	// Get all clusters first, then list the services of each
 	clusters, err := svc.GetAllClusters(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var allServices []types.Service
-	for _, c := range clusters {
-		services, err := svc.getServicesForCluster(ctx, *c.ClusterArn)
+	arns, err := awsutil.ParallelMap(ctx, svc.Concurrency, clusters, func(ctx context.Context, c types.Cluster) ([]string, error) {
+		arns, err := svc.ListServices(ctx, &ascTypes.ListServicesInput{Cluster: aws.ToString(c.ClusterArn)})
 		if err != nil {
-			return nil, fmt.Errorf("list services for cluster %s: %w", *c.ClusterName, err)
+			return nil, fmt.Errorf("list services for cluster %s: %w", aws.ToString(c.ClusterName), err)
 		}
-		allServices = append(allServices, services...)
+		return arns, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return allServices, nil
+	// Describe the batches of every cluster in a single pool, so that clusters with few services
+	// do not leave workers idle
+	var batches []describeBatch
+	for i, c := range clusters {
+		batches = append(batches, serviceBatches(aws.ToString(c.ClusterArn), arns[i])...)
+	}
+	return svc.describeServiceBatches(ctx, batches)
 }
 
 // getServicesForCluster lists and describes all services in a specific cluster.
@@ -275,6 +287,8 @@ func (svc *ECSService) getServicesForCluster(ctx context.Context, cluster string
 }
 
 // GetAllTasks lists and describes all tasks, optionally filtered by cluster and/or service.
+// Without a cluster, the tasks of every cluster are listed and described concurrently, and
+// returned in the order of the clusters.
 func (svc *ECSService) GetAllTasks(ctx context.Context, cluster string, serviceName string) ([]types.Task, error) {
 	if cluster != "" {
 		return svc.getTasksForCluster(ctx, cluster, serviceName)
@@ -285,16 +299,22 @@ func (svc *ECSService) GetAllTasks(ctx context.Context, cluster string, serviceN
 		return nil, err
 	}
 
-	var allTasks []types.Task
-	for _, c := range clusters {
-		tasks, err := svc.getTasksForCluster(ctx, *c.ClusterArn, serviceName)
+	arns, err := awsutil.ParallelMap(ctx, svc.Concurrency, clusters, func(ctx context.Context, c types.Cluster) ([]string, error) {
+		arns, err := svc.ListTasks(ctx, &ascTypes.ListTasksInput{Cluster: aws.ToString(c.ClusterArn), ServiceName: serviceName})
 		if err != nil {
-			return nil, fmt.Errorf("list tasks for cluster %s: %w", *c.ClusterName, err)
+			return nil, fmt.Errorf("list tasks for cluster %s: %w", aws.ToString(c.ClusterName), err)
 		}
-		allTasks = append(allTasks, tasks...)
+		return arns, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return allTasks, nil
+	var batches []describeBatch
+	for i, c := range clusters {
+		batches = append(batches, taskBatches(aws.ToString(c.ClusterArn), arns[i])...)
+	}
+	return svc.describeTaskBatches(ctx, batches)
 }
 
 // getTasksForCluster lists and describes all tasks in a specific cluster.
@@ -0,0 +1,284 @@
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockECSClient is a mock implementation of ECSClientAPI for unit tests, serving clusters and
+// the ARNs of their services and tasks. Describe calls take a random time, so that concurrent
+// calls complete out of order, and the number of calls in progress is tracked.
+type mockECSClient struct {
+	ECSClientAPI
+
+	clusters []string
+	services map[string][]string
+	tasks    map[string][]string
+	// failList and failDescribe make the calls for a cluster fail
+	failList     string
+	failDescribe string
+	// pageSize splits the cluster and service lists into pages, 0 for a single page
+	pageSize int
+
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	mu          sync.Mutex
+	calls       map[string]int
+}
+
+func newMockECSClient() *mockECSClient {
+	return &mockECSClient{
+		clusters: []string{"alpha", "beta", "gamma"},
+		services: map[string][]string{
+			"alpha": arns("alpha", "svc", 25),
+			"gamma": arns("gamma", "svc", 5),
+		},
+		tasks: map[string][]string{
+			"alpha": arns("alpha", "task", 150),
+			"beta":  arns("beta", "task", 3),
+		},
+		calls: map[string]int{},
+	}
+}
+
+// arns returns n ARNs of a resource type in a cluster.
+func arns(cluster, resource string, n int) []string {
+	var out []string
+	for i := range n {
+		out = append(out, fmt.Sprintf("arn:aws:ecs:eu-west-1:123456789012:%s/%s/%s-%03d", resource, cluster, resource, i))
+	}
+	return out
+}
+
+func clusterARN(name string) string {
+	return "arn:aws:ecs:eu-west-1:123456789012:cluster/" + name
+}
+
+// page returns the page of items starting at the token, and the token of the next page.
+func (m *mockECSClient) page(items []string, token *string) ([]string, *string) {
+	if m.pageSize == 0 {
+		return items, nil
+	}
+	start := 0
+	if token != nil {
+		start, _ = strconv.Atoi(*token)
+	}
+	end := min(start+m.pageSize, len(items))
+	if end == len(items) {
+		return items[start:end], nil
+	}
+	return items[start:end], aws.String(strconv.Itoa(end))
+}
+
+// call records a call and simulates its latency.
+func (m *mockECSClient) call(name string) func() {
+	m.mu.Lock()
+	m.calls[name]++
+	m.mu.Unlock()
+
+	n := m.inFlight.Add(1)
+	for {
+		highest := m.maxInFlight.Load()
+		if n <= highest || m.maxInFlight.CompareAndSwap(highest, n) {
+			break
+		}
+	}
+	time.Sleep(time.Duration(rand.IntN(3)) * time.Millisecond)
+	return func() { m.inFlight.Add(-1) }
+}
+
+func (m *mockECSClient) ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error) {
+	defer m.call("ListClusters")()
+	var out []string
+	for _, name := range m.clusters {
+		out = append(out, clusterARN(name))
+	}
+	page, next := m.page(out, params.NextToken)
+	return &ecs.ListClustersOutput{ClusterArns: page, NextToken: next}, nil
+}
+
+func (m *mockECSClient) DescribeClusters(ctx context.Context, params *ecs.DescribeClustersInput, optFns ...func(*ecs.Options)) (*ecs.DescribeClustersOutput, error) {
+	defer m.call("DescribeClusters")()
+	var out []types.Cluster
+	for _, arn := range params.Clusters {
+		out = append(out, types.Cluster{ClusterArn: aws.String(arn), ClusterName: aws.String(ShortARN(arn))})
+	}
+	return &ecs.DescribeClustersOutput{Clusters: out}, nil
+}
+
+func (m *mockECSClient) ListServices(ctx context.Context, params *ecs.ListServicesInput, optFns ...func(*ecs.Options)) (*ecs.ListServicesOutput, error) {
+	defer m.call("ListServices")()
+	cluster := ShortARN(aws.ToString(params.Cluster))
+	if cluster == m.failList {
+		return nil, errors.New("AccessDeniedException")
+	}
+	page, next := m.page(m.services[cluster], params.NextToken)
+	return &ecs.ListServicesOutput{ServiceArns: page, NextToken: next}, nil
+}
+
+func (m *mockECSClient) DescribeServices(ctx context.Context, params *ecs.DescribeServicesInput, optFns ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error) {
+	defer m.call("DescribeServices")()
+	if ShortARN(aws.ToString(params.Cluster)) == m.failDescribe {
+		return nil, errors.New("ThrottlingException")
+	}
+	var out []types.Service
+	for _, arn := range params.Services {
+		out = append(out, types.Service{ServiceArn: aws.String(arn), ClusterArn: params.Cluster})
+	}
+	return &ecs.DescribeServicesOutput{Services: out}, nil
+}
+
+func (m *mockECSClient) ListTasks(ctx context.Context, params *ecs.ListTasksInput, optFns ...func(*ecs.Options)) (*ecs.ListTasksOutput, error) {
+	defer m.call("ListTasks")()
+	cluster := ShortARN(aws.ToString(params.Cluster))
+	if cluster == m.failList {
+		return nil, errors.New("AccessDeniedException")
+	}
+	return &ecs.ListTasksOutput{TaskArns: m.tasks[cluster]}, nil
+}
+
+func (m *mockECSClient) DescribeTasks(ctx context.Context, params *ecs.DescribeTasksInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error) {
+	defer m.call("DescribeTasks")()
+	if ShortARN(aws.ToString(params.Cluster)) == m.failDescribe {
+		return nil, errors.New("ThrottlingException")
+	}
+	var out []types.Task
+	for _, arn := range params.Tasks {
+		out = append(out, types.Task{TaskArn: aws.String(arn), ClusterArn: params.Cluster})
+	}
+	return &ecs.DescribeTasksOutput{Tasks: out}, nil
+}
+
+// Unit test for GetAllServices returning the services of every cluster in order
+func TestGetAllServices(t *testing.T) {
+	client := newMockECSClient()
+	svc := &ECSService{Client: client, Concurrency: 4}
+
+	services, err := svc.GetAllServices(context.Background(), "")
+	require.NoError(t, err)
+
+	var got []string
+	for _, s := range services {
+		got = append(got, aws.ToString(s.ServiceArn))
+	}
+	assert.Equal(t, append(arns("alpha", "svc", 25), arns("gamma", "svc", 5)...), got)
+	// 3 batches of up to 10 for alpha, and 1 for gamma
+	assert.Equal(t, 4, client.calls["DescribeServices"])
+	assert.Equal(t, 3, client.calls["ListServices"])
+	assert.LessOrEqual(t, client.maxInFlight.Load(), int32(4))
+}
+
+// Unit test for GetAllTasks returning the tasks of every cluster in order
+func TestGetAllTasks(t *testing.T) {
+	client := newMockECSClient()
+	svc := &ECSService{Client: client, Concurrency: 2}
+
+	tasks, err := svc.GetAllTasks(context.Background(), "", "")
+	require.NoError(t, err)
+
+	var got []string
+	for _, task := range tasks {
+		got = append(got, aws.ToString(task.TaskArn))
+	}
+	assert.Equal(t, append(arns("alpha", "task", 150), arns("beta", "task", 3)...), got)
+	// 2 batches of up to 100 for alpha, and 1 for beta
+	assert.Equal(t, 3, client.calls["DescribeTasks"])
+	assert.LessOrEqual(t, client.maxInFlight.Load(), int32(2))
+}
+
+// Unit test for GetAllServices walking every page of clusters and services with --max-items set,
+// which caps only the rendered list
+func TestGetAllServicesMaxItems(t *testing.T) {
+	defer func() { tablewriter.MaxItems = 0 }()
+	tablewriter.MaxItems = 5
+	client := newMockECSClient()
+	client.pageSize = 2
+	svc := &ECSService{Client: client}
+
+	services, err := svc.GetAllServices(context.Background(), "")
+	require.NoError(t, err)
+	assert.Len(t, services, 30)
+	assert.Equal(t, 2, client.calls["ListClusters"])
+	// 13 pages for alpha, 1 for beta and 3 for gamma
+	assert.Equal(t, 17, client.calls["ListServices"])
+}
+
+// Unit test for GetAllServices with a concurrency of 1 making one call at a time
+func TestGetAllServicesSequential(t *testing.T) {
+	client := newMockECSClient()
+	svc := &ECSService{Client: client, Concurrency: 1}
+
+	services, err := svc.GetAllServices(context.Background(), "")
+	require.NoError(t, err)
+	assert.Len(t, services, 30)
+	assert.Equal(t, int32(1), client.maxInFlight.Load())
+}
+
+// Unit test for GetAllServices reporting the cluster that failed
+func TestGetAllServicesErrors(t *testing.T) {
+	client := newMockECSClient()
+	client.failList = "beta"
+	svc := &ECSService{Client: client}
+
+	_, err := svc.GetAllServices(context.Background(), "")
+	assert.ErrorContains(t, err, "list services for cluster beta: AccessDeniedException")
+
+	client = newMockECSClient()
+	client.failDescribe = "gamma"
+	svc = &ECSService{Client: client}
+
+	_, err = svc.GetAllServices(context.Background(), "")
+	assert.ErrorContains(t, err, "describe services in cluster gamma: ThrottlingException")
+}
+
+// Unit test for GetAllTasks with a cluster describing only that cluster's tasks
+func TestGetAllTasksForCluster(t *testing.T) {
+	client := newMockECSClient()
+	svc := &ECSService{Client: client}
+
+	tasks, err := svc.GetAllTasks(context.Background(), clusterARN("beta"), "")
+	require.NoError(t, err)
+	assert.Len(t, tasks, 3)
+	assert.Zero(t, client.calls["ListClusters"])
+}
+
+// Unit test for IsFailedTask
+func TestIsFailedTask(t *testing.T) {
+	exited := func(codes ...int32) []types.Container {
+		var containers []types.Container
+		for _, code := range codes {
+			containers = append(containers, types.Container{ExitCode: aws.Int32(code)})
+		}
+		return containers
+	}
+	tests := []struct {
+		name string
+		task types.Task
+		want bool
+	}{
+		{name: "running", task: types.Task{LastStatus: aws.String("RUNNING")}, want: false},
+		{name: "stopped with exit 0", task: types.Task{LastStatus: aws.String("STOPPED"), Containers: exited(0, 0)}, want: false},
+		{name: "stopped with a non-zero exit", task: types.Task{LastStatus: aws.String("STOPPED"), Containers: exited(0, 137)}, want: true},
+		{name: "failed to start", task: types.Task{LastStatus: aws.String("STOPPED"), StopCode: types.TaskStopCodeTaskFailedToStart}, want: true},
+		{name: "stopped without an exit code", task: types.Task{LastStatus: aws.String("STOPPED"), Containers: []types.Container{{}}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsFailedTask(tt.task))
+		})
+	}
+}
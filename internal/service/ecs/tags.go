@@ -0,0 +1,96 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	ascTypes "github.com/harleymckenzie/asc/internal/service/ecs/types"
+)
+
+// GetClusterARN returns the ARN of a cluster.
+func (svc *ECSService) GetClusterARN(ctx context.Context, cluster string) (string, error) {
+	clusters, err := svc.DescribeClusters(ctx, &ascTypes.DescribeClustersInput{ClusterARNs: []string{cluster}})
+	if err != nil {
+		return "", fmt.Errorf("describe cluster: %w", err)
+	}
+	if len(clusters) == 0 {
+		return "", fmt.Errorf("cluster %s not found", cluster)
+	}
+	return aws.ToString(clusters[0].ClusterArn), nil
+}
+
+// GetServiceARN returns the ARN of a service in the given cluster.
+func (svc *ECSService) GetServiceARN(ctx context.Context, cluster string, serviceName string) (string, error) {
+	services, err := svc.DescribeServices(ctx, &ascTypes.DescribeServicesInput{Cluster: cluster, Services: []string{serviceName}})
+	if err != nil {
+		return "", fmt.Errorf("describe service: %w", err)
+	}
+	if len(services) == 0 {
+		return "", fmt.Errorf("service %s not found in cluster %s", serviceName, cluster)
+	}
+	return aws.ToString(services[0].ServiceArn), nil
+}
+
+// GetTaskARN returns the ARN of a task in the given cluster.
+func (svc *ECSService) GetTaskARN(ctx context.Context, cluster string, taskID string) (string, error) {
+	tasks, err := svc.DescribeTasks(ctx, &ascTypes.DescribeTasksInput{Cluster: cluster, Tasks: []string{taskID}})
+	if err != nil {
+		return "", fmt.Errorf("describe task: %w", err)
+	}
+	if len(tasks) == 0 {
+		return "", fmt.Errorf("task %s not found in cluster %s", taskID, cluster)
+	}
+	return aws.ToString(tasks[0].TaskArn), nil
+}
+
+// GetTaskDefinitionARN returns the ARN of a task definition, given as family or family:revision.
+func (svc *ECSService) GetTaskDefinitionARN(ctx context.Context, taskDefinition string) (string, error) {
+	td, err := svc.DescribeTaskDefinition(ctx, &ascTypes.DescribeTaskDefinitionInput{TaskDefinition: taskDefinition})
+	if err != nil {
+		return "", fmt.Errorf("describe task definition: %w", err)
+	}
+	return aws.ToString(td.TaskDefinitionArn), nil
+}
+
+// TagResource adds or overwrites tags on the ECS resource with the given ARN.
+func (svc *ECSService) TagResource(ctx context.Context, arn string, tags map[string]string) error {
+	var ecsTags []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		ecsTags = append(ecsTags, types.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	_, err := svc.Client.TagResource(ctx, &ecs.TagResourceInput{
+		ResourceArn: aws.String(arn),
+		Tags:        ecsTags,
+	})
+	return err
+}
+
+// UntagResource removes the tags with the given keys from the ECS resource with the given ARN.
+func (svc *ECSService) UntagResource(ctx context.Context, arn string, keys []string) error {
+	_, err := svc.Client.UntagResource(ctx, &ecs.UntagResourceInput{
+		ResourceArn: aws.String(arn),
+		TagKeys:     keys,
+	})
+	return err
+}
+
+// GetTags returns the tags of the ECS resource with the given ARN.
+func (svc *ECSService) GetTags(ctx context.Context, arn string) (map[string]string, error) {
+	output, err := svc.Client.ListTagsForResource(ctx, &ecs.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(output.Tags))
+	for _, tag := range output.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	ecssdk "github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 )
@@ -30,6 +31,29 @@ func IsTerminalTaskState(status string) bool {
 	return taskTerminalStates[strings.ToLower(status)]
 }
 
+// IsFinalServiceState returns true if the ECS service is being deleted or has been deleted, and
+// so can no longer become active.
+func IsFinalServiceState(status string) bool {
+	return strings.EqualFold(status, "draining") || strings.EqualFold(status, "inactive")
+}
+
+// IsFailedTask returns true if the ECS task stopped because it failed to start, or because a
+// container exited with a non-zero code.
+func IsFailedTask(task types.Task) bool {
+	if !strings.EqualFold(aws.ToString(task.LastStatus), "stopped") {
+		return false
+	}
+	if task.StopCode == types.TaskStopCodeTaskFailedToStart {
+		return true
+	}
+	for _, container := range task.Containers {
+		if aws.ToInt32(container.ExitCode) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // GetServiceStatus returns the current status of an ECS service.
 func (svc *ECSService) GetServiceStatus(ctx context.Context, cluster, serviceName string) (string, error) {
 	output, err := svc.Client.DescribeServices(ctx, &ecssdk.DescribeServicesInput{
@@ -49,21 +73,30 @@ func (svc *ECSService) GetServiceStatus(ctx context.Context, cluster, serviceNam
 	return *output.Services[0].Status, nil
 }
 
-// GetTaskStatus returns the current status of an ECS task.
-func (svc *ECSService) GetTaskStatus(ctx context.Context, cluster, taskID string) (string, error) {
+// GetTask returns an ECS task, for polling its status.
+func (svc *ECSService) GetTask(ctx context.Context, cluster, taskID string) (types.Task, error) {
 	output, err := svc.Client.DescribeTasks(ctx, &ecssdk.DescribeTasksInput{
 		Cluster: &cluster,
 		Tasks:   []string{taskID},
 		Include: []types.TaskField{},
 	})
 	if err != nil {
-		return "", fmt.Errorf("describe task: %w", err)
+		return types.Task{}, fmt.Errorf("describe task: %w", err)
 	}
 	if len(output.Tasks) == 0 {
-		return "", fmt.Errorf("task %s not found in cluster %s", taskID, cluster)
+		return types.Task{}, fmt.Errorf("task %s not found in cluster %s", taskID, cluster)
 	}
 	if output.Tasks[0].LastStatus == nil {
-		return "", fmt.Errorf("task %s has no status", taskID)
+		return types.Task{}, fmt.Errorf("task %s has no status", taskID)
+	}
+	return output.Tasks[0], nil
+}
+
+// GetTaskStatus returns the current status of an ECS task.
+func (svc *ECSService) GetTaskStatus(ctx context.Context, cluster, taskID string) (string, error) {
+	task, err := svc.GetTask(ctx, cluster, taskID)
+	if err != nil {
+		return "", err
 	}
-	return *output.Tasks[0].LastStatus, nil
+	return *task.LastStatus, nil
 }
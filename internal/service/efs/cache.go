@@ -0,0 +1,27 @@
+package efs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an EFSClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	EFSClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client EFSClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{EFSClientAPI: client, cache: awsutil.NewResponseCache(cfg, "elasticfilesystem")}
+}
+
+func (c *cachedClient) DescribeFileSystems(ctx context.Context, params *efs.DescribeFileSystemsInput, optFns ...func(*efs.Options)) (*efs.DescribeFileSystemsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeFileSystems", params, func() (*efs.DescribeFileSystemsOutput, error) {
+		return c.EFSClientAPI.DescribeFileSystems(ctx, params, optFns...)
+	})
+}
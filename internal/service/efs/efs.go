@@ -26,19 +26,15 @@ func NewEFSService(ctx context.Context, profile string, region string) (*EFSServ
 		return nil, err
 	}
 
-	client := efs.NewFromConfig(cfg.Config)
-	return &EFSService{Client: client}, nil
+	client := efs.NewFromConfig(cfg.ServiceConfig("elasticfilesystem"))
+	return &EFSService{Client: newCachedClient(client, cfg)}, nil
 }
 
 func (svc *EFSService) GetFileSystems(ctx context.Context) ([]types.FileSystemDescription, error) {
-	output, err := svc.Client.DescribeFileSystems(ctx, &efs.DescribeFileSystemsInput{})
-	if err != nil {
-		return nil, err
-	}
-
-	var fileSystems []types.FileSystemDescription
-	fileSystems = append(fileSystems, output.FileSystems...)
-	return fileSystems, nil
+	paginator := efs.NewDescribeFileSystemsPaginator(svc.Client, &efs.DescribeFileSystemsInput{})
+	return awsutil.CollectPages(ctx, paginator, func(page *efs.DescribeFileSystemsOutput) []types.FileSystemDescription {
+		return page.FileSystems
+	})
 }
 
 func (svc *EFSService) GetFileSystem(ctx context.Context, identifier string) (types.FileSystemDescription, error) {
@@ -0,0 +1,37 @@
+package elasticache
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an ElasticacheClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	ElasticacheClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client ElasticacheClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{ElasticacheClientAPI: client, cache: awsutil.NewResponseCache(cfg, "elasticache")}
+}
+
+func (c *cachedClient) DescribeCacheClusters(ctx context.Context, params *elasticache.DescribeCacheClustersInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeCacheClusters", params, func() (*elasticache.DescribeCacheClustersOutput, error) {
+		return c.ElasticacheClientAPI.DescribeCacheClusters(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) AddTagsToResource(ctx context.Context, params *elasticache.AddTagsToResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.AddTagsToResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.ElasticacheClientAPI.AddTagsToResource(ctx, params, optFns...)
+}
+
+func (c *cachedClient) RemoveTagsFromResource(ctx context.Context, params *elasticache.RemoveTagsFromResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.RemoveTagsFromResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.ElasticacheClientAPI.RemoveTagsFromResource(ctx, params, optFns...)
+}
@@ -13,6 +13,9 @@ import (
 
 type ElasticacheClientAPI interface {
 	DescribeCacheClusters(context.Context, *elasticache.DescribeCacheClustersInput, ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error)
+	AddTagsToResource(context.Context, *elasticache.AddTagsToResourceInput, ...func(*elasticache.Options)) (*elasticache.AddTagsToResourceOutput, error)
+	RemoveTagsFromResource(context.Context, *elasticache.RemoveTagsFromResourceInput, ...func(*elasticache.Options)) (*elasticache.RemoveTagsFromResourceOutput, error)
+	ListTagsForResource(context.Context, *elasticache.ListTagsForResourceInput, ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error)
 }
 
 // ElasticacheService is a struct that holds the Elasticache client.
@@ -30,19 +33,15 @@ func NewElasticacheService(ctx context.Context, profile string, region string) (
 		return nil, err
 	}
 
-	client := elasticache.NewFromConfig(cfg.Config)
-	return &ElasticacheService{Client: client}, nil
+	client := elasticache.NewFromConfig(cfg.ServiceConfig("elasticache"))
+	return &ElasticacheService{Client: newCachedClient(client, cfg)}, nil
 }
 
 func (svc *ElasticacheService) GetInstances(ctx context.Context) ([]types.CacheCluster, error) {
-	output, err := svc.Client.DescribeCacheClusters(ctx, &elasticache.DescribeCacheClustersInput{
+	paginator := elasticache.NewDescribeCacheClustersPaginator(svc.Client, &elasticache.DescribeCacheClustersInput{
 		ShowCacheNodeInfo: aws.Bool(true),
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var instances []types.CacheCluster
-	instances = append(instances, output.CacheClusters...)
-	return instances, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *elasticache.DescribeCacheClustersOutput) []types.CacheCluster {
+		return page.CacheClusters
+	})
 }
@@ -0,0 +1,64 @@
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+)
+
+// GetClusterARN returns the ARN of a cache cluster.
+func (svc *ElasticacheService) GetClusterARN(ctx context.Context, clusterID string) (string, error) {
+	output, err := svc.Client.DescribeCacheClusters(ctx, &elasticache.DescribeCacheClustersInput{
+		CacheClusterId: aws.String(clusterID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe cache cluster: %w", err)
+	}
+	if len(output.CacheClusters) == 0 {
+		return "", fmt.Errorf("cache cluster %s not found", clusterID)
+	}
+	return aws.ToString(output.CacheClusters[0].ARN), nil
+}
+
+// TagResource adds or overwrites tags on the ElastiCache resource with the given ARN.
+func (svc *ElasticacheService) TagResource(ctx context.Context, arn string, tags map[string]string) error {
+	var cacheTags []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		cacheTags = append(cacheTags, types.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	_, err := svc.Client.AddTagsToResource(ctx, &elasticache.AddTagsToResourceInput{
+		ResourceName: aws.String(arn),
+		Tags:         cacheTags,
+	})
+	return err
+}
+
+// UntagResource removes the tags with the given keys from the ElastiCache resource with the
+// given ARN.
+func (svc *ElasticacheService) UntagResource(ctx context.Context, arn string, keys []string) error {
+	_, err := svc.Client.RemoveTagsFromResource(ctx, &elasticache.RemoveTagsFromResourceInput{
+		ResourceName: aws.String(arn),
+		TagKeys:      keys,
+	})
+	return err
+}
+
+// GetTags returns the tags of the ElastiCache resource with the given ARN.
+func (svc *ElasticacheService) GetTags(ctx context.Context, arn string) (map[string]string, error) {
+	output, err := svc.Client.ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{
+		ResourceName: aws.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(output.TagList))
+	for _, tag := range output.TagList {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
+}
@@ -22,6 +22,15 @@ func IsTerminalClusterState(status string) bool {
 	return elasticacheTerminalStates[strings.ToLower(status)]
 }
 
+// IsFailedClusterState returns true if the ElastiCache cluster status is a failure.
+func IsFailedClusterState(status string) bool {
+	switch strings.ToLower(status) {
+	case "create-failed", "incompatible-network", "restore-failed":
+		return true
+	}
+	return false
+}
+
 // GetClusterStatus returns the current status of an ElastiCache cluster.
 func (svc *ElasticacheService) GetClusterStatus(ctx context.Context, clusterID string) (string, error) {
 	output, err := svc.Client.DescribeCacheClusters(ctx, &ecsdk.DescribeCacheClustersInput{
@@ -0,0 +1,43 @@
+package elb
+
+import (
+	"context"
+
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an ELBClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	ELBClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client ELBClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{ELBClientAPI: client, cache: awsutil.NewResponseCache(cfg, "elasticloadbalancing")}
+}
+
+func (c *cachedClient) DescribeLoadBalancers(ctx context.Context, params *elbv2.DescribeLoadBalancersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeLoadBalancersOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeLoadBalancers", params, func() (*elbv2.DescribeLoadBalancersOutput, error) {
+		return c.ELBClientAPI.DescribeLoadBalancers(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeTargetGroups(ctx context.Context, params *elbv2.DescribeTargetGroupsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetGroupsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeTargetGroups", params, func() (*elbv2.DescribeTargetGroupsOutput, error) {
+		return c.ELBClientAPI.DescribeTargetGroups(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) AddTags(ctx context.Context, params *elbv2.AddTagsInput, optFns ...func(*elbv2.Options)) (*elbv2.AddTagsOutput, error) {
+	defer c.cache.Invalidate()
+	return c.ELBClientAPI.AddTags(ctx, params, optFns...)
+}
+
+func (c *cachedClient) RemoveTags(ctx context.Context, params *elbv2.RemoveTagsInput, optFns ...func(*elbv2.Options)) (*elbv2.RemoveTagsOutput, error) {
+	defer c.cache.Invalidate()
+	return c.ELBClientAPI.RemoveTags(ctx, params, optFns...)
+}
@@ -14,6 +14,9 @@ import (
 type ELBClientAPI interface {
 	DescribeLoadBalancers(ctx context.Context, params *elbv2.DescribeLoadBalancersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeLoadBalancersOutput, error)
 	DescribeTargetGroups(ctx context.Context, params *elbv2.DescribeTargetGroupsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetGroupsOutput, error)
+	AddTags(ctx context.Context, params *elbv2.AddTagsInput, optFns ...func(*elbv2.Options)) (*elbv2.AddTagsOutput, error)
+	RemoveTags(ctx context.Context, params *elbv2.RemoveTagsInput, optFns ...func(*elbv2.Options)) (*elbv2.RemoveTagsOutput, error)
+	DescribeTags(ctx context.Context, params *elbv2.DescribeTagsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTagsOutput, error)
 }
 
 type ELBService struct {
@@ -31,35 +34,27 @@ func NewELBService(ctx context.Context, profile string, region string) (*ELBServ
 		return nil, err
 	}
 
-	return &ELBService{Client: elbv2.NewFromConfig(cfg.Config)}, nil
+	return &ELBService{Client: newCachedClient(elbv2.NewFromConfig(cfg.ServiceConfig("elasticloadbalancing")), cfg)}, nil
 }
 
 // GetLoadBalancers gets all the load balancers.
 func (svc *ELBService) GetLoadBalancers(ctx context.Context, input *ascTypes.GetLoadBalancersInput) ([]types.LoadBalancer, error) {
-	output, err := svc.Client.DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(svc.Client, &elbv2.DescribeLoadBalancersInput{
 		Names: input.ListLoadBalancersInput.Names,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var loadBalancers []types.LoadBalancer
-	loadBalancers = append(loadBalancers, output.LoadBalancers...)
-	return loadBalancers, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *elbv2.DescribeLoadBalancersOutput) []types.LoadBalancer {
+		return page.LoadBalancers
+	})
 }
 
 // GetTargetGroups gets all the target groups.
 func (svc *ELBService) GetTargetGroups(ctx context.Context, input *ascTypes.GetTargetGroupsInput) ([]types.TargetGroup, error) {
-	output, err := svc.Client.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+	paginator := elbv2.NewDescribeTargetGroupsPaginator(svc.Client, &elbv2.DescribeTargetGroupsInput{
 		Names: input.ListTargetGroupsInput.Names,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var targetGroups []types.TargetGroup
-	targetGroups = append(targetGroups, output.TargetGroups...)
-	return targetGroups, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *elbv2.DescribeTargetGroupsOutput) []types.TargetGroup {
+		return page.TargetGroups
+	})
 }
 
 // getTargetGroupLoadBalancer gets the load balancer name from the target group.
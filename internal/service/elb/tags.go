@@ -0,0 +1,69 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// GetLoadBalancerARN returns the ARN of a load balancer. ARNs are returned unchanged.
+func (svc *ELBService) GetLoadBalancerARN(ctx context.Context, lbName string) (string, error) {
+	if strings.HasPrefix(lbName, "arn:") {
+		return lbName, nil
+	}
+	output, err := svc.Client.DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{
+		Names: []string{lbName},
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe load balancer: %w", err)
+	}
+	if len(output.LoadBalancers) == 0 {
+		return "", fmt.Errorf("load balancer %s not found", lbName)
+	}
+	return aws.ToString(output.LoadBalancers[0].LoadBalancerArn), nil
+}
+
+// TagResource adds or overwrites tags on the ELB resource with the given ARN.
+func (svc *ELBService) TagResource(ctx context.Context, arn string, tags map[string]string) error {
+	var elbTags []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		elbTags = append(elbTags, types.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	_, err := svc.Client.AddTags(ctx, &elbv2.AddTagsInput{
+		ResourceArns: []string{arn},
+		Tags:         elbTags,
+	})
+	return err
+}
+
+// UntagResource removes the tags with the given keys from the ELB resource with the given ARN.
+func (svc *ELBService) UntagResource(ctx context.Context, arn string, keys []string) error {
+	_, err := svc.Client.RemoveTags(ctx, &elbv2.RemoveTagsInput{
+		ResourceArns: []string{arn},
+		TagKeys:      keys,
+	})
+	return err
+}
+
+// GetTags returns the tags of the ELB resource with the given ARN.
+func (svc *ELBService) GetTags(ctx context.Context, arn string) (map[string]string, error) {
+	output, err := svc.Client.DescribeTags(ctx, &elbv2.DescribeTagsInput{
+		ResourceArns: []string{arn},
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := map[string]string{}
+	for _, description := range output.TagDescriptions {
+		for _, tag := range description.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	return tags, nil
+}
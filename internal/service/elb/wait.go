@@ -19,6 +19,11 @@ func IsTerminalLoadBalancerState(status string) bool {
 	return elbTerminalStates[strings.ToLower(status)]
 }
 
+// IsFailedLoadBalancerState returns true if the load balancer state is a failure.
+func IsFailedLoadBalancerState(status string) bool {
+	return strings.EqualFold(status, "failed")
+}
+
 // GetLoadBalancerStatus returns the current state of a load balancer.
 func (svc *ELBService) GetLoadBalancerStatus(ctx context.Context, lbName string) (string, error) {
 	output, err := svc.Client.DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{
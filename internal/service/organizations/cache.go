@@ -0,0 +1,69 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an OrganizationsClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	OrganizationsClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client OrganizationsClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{OrganizationsClientAPI: client, cache: awsutil.NewResponseCache(cfg, "organizations")}
+}
+
+func (c *cachedClient) ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+	return awsutil.Cached(c.cache, "ListAccounts", params, func() (*organizations.ListAccountsOutput, error) {
+		return c.OrganizationsClientAPI.ListAccounts(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListAccountsForParent(ctx context.Context, params *organizations.ListAccountsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsForParentOutput, error) {
+	return awsutil.Cached(c.cache, "ListAccountsForParent", params, func() (*organizations.ListAccountsForParentOutput, error) {
+		return c.OrganizationsClientAPI.ListAccountsForParent(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListRoots(ctx context.Context, params *organizations.ListRootsInput, optFns ...func(*organizations.Options)) (*organizations.ListRootsOutput, error) {
+	return awsutil.Cached(c.cache, "ListRoots", params, func() (*organizations.ListRootsOutput, error) {
+		return c.OrganizationsClientAPI.ListRoots(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListOrganizationalUnitsForParent(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	return awsutil.Cached(c.cache, "ListOrganizationalUnitsForParent", params, func() (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+		return c.OrganizationsClientAPI.ListOrganizationalUnitsForParent(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ListTagsForResource(ctx context.Context, params *organizations.ListTagsForResourceInput, optFns ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error) {
+	return awsutil.Cached(c.cache, "ListTagsForResource", params, func() (*organizations.ListTagsForResourceOutput, error) {
+		return c.OrganizationsClientAPI.ListTagsForResource(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeOrganization(ctx context.Context, params *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeOrganization", params, func() (*organizations.DescribeOrganizationOutput, error) {
+		return c.OrganizationsClientAPI.DescribeOrganization(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeAccount(ctx context.Context, params *organizations.DescribeAccountInput, optFns ...func(*organizations.Options)) (*organizations.DescribeAccountOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeAccount", params, func() (*organizations.DescribeAccountOutput, error) {
+		return c.OrganizationsClientAPI.DescribeAccount(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeOrganizationalUnit(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeOrganizationalUnit", params, func() (*organizations.DescribeOrganizationalUnitOutput, error) {
+		return c.OrganizationsClientAPI.DescribeOrganizationalUnit(ctx, params, optFns...)
+	})
+}
@@ -34,8 +34,8 @@ func NewOrganizationsService(ctx context.Context, profile string, region string)
 		return nil, err
 	}
 
-	client := organizations.NewFromConfig(cfg.Config)
-	return &OrganizationsService{Client: client}, nil
+	client := organizations.NewFromConfig(cfg.ServiceConfig("organizations"))
+	return &OrganizationsService{Client: newCachedClient(client, cfg)}, nil
 }
 
 // GetAccounts returns all accounts in the organization.
@@ -0,0 +1,70 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an RDSClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	RDSClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client RDSClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{RDSClientAPI: client, cache: awsutil.NewResponseCache(cfg, "rds")}
+}
+
+func (c *cachedClient) DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeDBInstances", params, func() (*rds.DescribeDBInstancesOutput, error) {
+		return c.RDSClientAPI.DescribeDBInstances(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeDBClusters", params, func() (*rds.DescribeDBClustersOutput, error) {
+		return c.RDSClientAPI.DescribeDBClusters(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeDBSnapshots(ctx context.Context, params *rds.DescribeDBSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBSnapshotsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeDBSnapshots", params, func() (*rds.DescribeDBSnapshotsOutput, error) {
+		return c.RDSClientAPI.DescribeDBSnapshots(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeDBClusterSnapshots(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeDBClusterSnapshots", params, func() (*rds.DescribeDBClusterSnapshotsOutput, error) {
+		return c.RDSClientAPI.DescribeDBClusterSnapshots(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) ModifyDBInstance(ctx context.Context, params *rds.ModifyDBInstanceInput, optFns ...func(*rds.Options)) (*rds.ModifyDBInstanceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.RDSClientAPI.ModifyDBInstance(ctx, params, optFns...)
+}
+
+func (c *cachedClient) CreateDBSnapshot(ctx context.Context, params *rds.CreateDBSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBSnapshotOutput, error) {
+	defer c.cache.Invalidate()
+	return c.RDSClientAPI.CreateDBSnapshot(ctx, params, optFns...)
+}
+
+func (c *cachedClient) CreateDBClusterSnapshot(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error) {
+	defer c.cache.Invalidate()
+	return c.RDSClientAPI.CreateDBClusterSnapshot(ctx, params, optFns...)
+}
+
+func (c *cachedClient) AddTagsToResource(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.RDSClientAPI.AddTagsToResource(ctx, params, optFns...)
+}
+
+func (c *cachedClient) RemoveTagsFromResource(ctx context.Context, params *rds.RemoveTagsFromResourceInput, optFns ...func(*rds.Options)) (*rds.RemoveTagsFromResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.RDSClientAPI.RemoveTagsFromResource(ctx, params, optFns...)
+}
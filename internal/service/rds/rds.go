@@ -2,8 +2,6 @@ package rds
 
 import (
 	"context"
-	"fmt"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
@@ -21,6 +19,9 @@ type RDSClientAPI interface {
 	ModifyDBInstance(context.Context, *rds.ModifyDBInstanceInput, ...func(*rds.Options)) (*rds.ModifyDBInstanceOutput, error)
 	CreateDBSnapshot(context.Context, *rds.CreateDBSnapshotInput, ...func(*rds.Options)) (*rds.CreateDBSnapshotOutput, error)
 	CreateDBClusterSnapshot(context.Context, *rds.CreateDBClusterSnapshotInput, ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error)
+	AddTagsToResource(context.Context, *rds.AddTagsToResourceInput, ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error)
+	RemoveTagsFromResource(context.Context, *rds.RemoveTagsFromResourceInput, ...func(*rds.Options)) (*rds.RemoveTagsFromResourceOutput, error)
+	ListTagsForResource(context.Context, *rds.ListTagsForResourceInput, ...func(*rds.Options)) (*rds.ListTagsForResourceOutput, error)
 }
 
 // RDSService is the service for the RDS client.
@@ -35,36 +36,28 @@ func NewRDSService(ctx context.Context, profile string, region string) (*RDSServ
 		return nil, err
 	}
 
-	client := rds.NewFromConfig(cfg.Config)
-	return &RDSService{Client: client}, nil
+	client := rds.NewFromConfig(cfg.ServiceConfig("rds"))
+	return &RDSService{Client: newCachedClient(client, cfg)}, nil
 }
 
 // GetInstances gets all the RDS instances.
 func (svc *RDSService) GetInstances(ctx context.Context, input *ascTypes.GetInstancesInput) ([]types.DBInstance, error) {
-	output, err := svc.Client.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+	paginator := rds.NewDescribeDBInstancesPaginator(svc.Client, &rds.DescribeDBInstancesInput{
 		DBInstanceIdentifier: &input.InstanceIdentifier,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var instances []types.DBInstance
-	instances = append(instances, output.DBInstances...)
-	return instances, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *rds.DescribeDBInstancesOutput) []types.DBInstance {
+		return page.DBInstances
+	})
 }
 
 // GetClusters gets all the RDS clusters.
 func (svc *RDSService) GetClusters(ctx context.Context, input *ascTypes.GetClustersInput) ([]types.DBCluster, error) {
-	clusterOutput, err := svc.Client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+	paginator := rds.NewDescribeDBClustersPaginator(svc.Client, &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: &input.ClusterIdentifier,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var clusters []types.DBCluster
-	clusters = append(clusters, clusterOutput.DBClusters...)
-	return clusters, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *rds.DescribeDBClustersOutput) []types.DBCluster {
+		return page.DBClusters
+	})
 }
 
 // ModifyInstance modifies an RDS instance.
@@ -94,26 +87,3 @@ func (svc *RDSService) CreateSnapshot(ctx context.Context, input *ascTypes.Creat
 	})
 	return err
 }
-
-// WaitForSnapshot waits for an RDS snapshot to become available.
-func (svc *RDSService) WaitForSnapshot(ctx context.Context, input *ascTypes.CreateSnapshotInput, maxWait time.Duration) error {
-	if input.IsCluster {
-		waiter := rds.NewDBClusterSnapshotAvailableWaiter(svc.Client)
-		err := waiter.Wait(ctx, &rds.DescribeDBClusterSnapshotsInput{
-			DBClusterSnapshotIdentifier: &input.SnapshotIdentifier,
-		}, maxWait)
-		if err != nil {
-			return fmt.Errorf("wait for cluster snapshot: %w", err)
-		}
-		return nil
-	}
-
-	waiter := rds.NewDBSnapshotAvailableWaiter(svc.Client)
-	err := waiter.Wait(ctx, &rds.DescribeDBSnapshotsInput{
-		DBSnapshotIdentifier: &input.SnapshotIdentifier,
-	}, maxWait)
-	if err != nil {
-		return fmt.Errorf("wait for snapshot: %w", err)
-	}
-	return nil
-}
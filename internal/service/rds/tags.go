@@ -0,0 +1,77 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// GetInstanceARN returns the ARN of a DB instance.
+func (svc *RDSService) GetInstanceARN(ctx context.Context, instanceID string) (string, error) {
+	output, err := svc.Client.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe DB instance: %w", err)
+	}
+	if len(output.DBInstances) == 0 {
+		return "", fmt.Errorf("DB instance %s not found", instanceID)
+	}
+	return aws.ToString(output.DBInstances[0].DBInstanceArn), nil
+}
+
+// GetClusterARN returns the ARN of a DB cluster.
+func (svc *RDSService) GetClusterARN(ctx context.Context, clusterID string) (string, error) {
+	output, err := svc.Client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe DB cluster: %w", err)
+	}
+	if len(output.DBClusters) == 0 {
+		return "", fmt.Errorf("DB cluster %s not found", clusterID)
+	}
+	return aws.ToString(output.DBClusters[0].DBClusterArn), nil
+}
+
+// TagResource adds or overwrites tags on the RDS resource with the given ARN.
+func (svc *RDSService) TagResource(ctx context.Context, arn string, tags map[string]string) error {
+	var rdsTags []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		rdsTags = append(rdsTags, types.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	_, err := svc.Client.AddTagsToResource(ctx, &rds.AddTagsToResourceInput{
+		ResourceName: aws.String(arn),
+		Tags:         rdsTags,
+	})
+	return err
+}
+
+// UntagResource removes the tags with the given keys from the RDS resource with the given ARN.
+func (svc *RDSService) UntagResource(ctx context.Context, arn string, keys []string) error {
+	_, err := svc.Client.RemoveTagsFromResource(ctx, &rds.RemoveTagsFromResourceInput{
+		ResourceName: aws.String(arn),
+		TagKeys:      keys,
+	})
+	return err
+}
+
+// GetTags returns the tags of the RDS resource with the given ARN.
+func (svc *RDSService) GetTags(ctx context.Context, arn string) (map[string]string, error) {
+	output, err := svc.Client.ListTagsForResource(ctx, &rds.ListTagsForResourceInput{
+		ResourceName: aws.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(output.TagList))
+	for _, tag := range output.TagList {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
+}
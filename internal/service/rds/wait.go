@@ -41,6 +41,47 @@ func IsTerminalClusterState(status string) bool {
 	return rdsClusterTerminalStates[strings.ToLower(status)]
 }
 
+var rdsInstanceFailedStates = map[string]bool{
+	"failed":                              true,
+	"storage-full":                        true,
+	"incompatible-credentials":            true,
+	"incompatible-parameters":             true,
+	"incompatible-restore":                true,
+	"inaccessible-encryption-credentials": true,
+}
+
+var rdsClusterFailedStates = map[string]bool{
+	"inaccessible-encryption-credentials": true,
+	"migration-failed":                    true,
+}
+
+// IsFailedInstanceState returns true if the RDS instance status is a failure.
+func IsFailedInstanceState(status string) bool {
+	return rdsInstanceFailedStates[strings.ToLower(status)]
+}
+
+// IsFailedClusterState returns true if the RDS cluster status is a failure.
+func IsFailedClusterState(status string) bool {
+	return rdsClusterFailedStates[strings.ToLower(status)]
+}
+
+var rdsSnapshotTerminalStates = map[string]bool{
+	"available": true,
+	"failed":    true,
+	"deleted":   true,
+}
+
+// IsTerminalSnapshotState returns true if the RDS instance or cluster snapshot status is a
+// stable, non-processing state.
+func IsTerminalSnapshotState(status string) bool {
+	return rdsSnapshotTerminalStates[strings.ToLower(status)]
+}
+
+// IsFailedSnapshotState returns true if the RDS instance or cluster snapshot status is a failure.
+func IsFailedSnapshotState(status string) bool {
+	return strings.EqualFold(status, "failed")
+}
+
 // GetInstanceStatus returns the current status of an RDS instance.
 func (svc *RDSService) GetInstanceStatus(ctx context.Context, identifier string) (string, error) {
 	output, err := svc.Client.DescribeDBInstances(ctx, &rdssdk.DescribeDBInstancesInput{
@@ -68,3 +109,31 @@ func (svc *RDSService) GetClusterStatus(ctx context.Context, identifier string)
 	}
 	return *output.DBClusters[0].Status, nil
 }
+
+// GetSnapshotStatus returns the current status of an RDS instance snapshot.
+func (svc *RDSService) GetSnapshotStatus(ctx context.Context, identifier string) (string, error) {
+	output, err := svc.Client.DescribeDBSnapshots(ctx, &rdssdk.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: &identifier,
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe DB snapshot: %w", err)
+	}
+	if len(output.DBSnapshots) == 0 {
+		return "", fmt.Errorf("DB snapshot %s not found", identifier)
+	}
+	return *output.DBSnapshots[0].Status, nil
+}
+
+// GetClusterSnapshotStatus returns the current status of an RDS cluster snapshot.
+func (svc *RDSService) GetClusterSnapshotStatus(ctx context.Context, identifier string) (string, error) {
+	output, err := svc.Client.DescribeDBClusterSnapshots(ctx, &rdssdk.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: &identifier,
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe DB cluster snapshot: %w", err)
+	}
+	if len(output.DBClusterSnapshots) == 0 {
+		return "", fmt.Errorf("DB cluster snapshot %s not found", identifier)
+	}
+	return *output.DBClusterSnapshots[0].Status, nil
+}
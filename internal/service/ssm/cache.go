@@ -0,0 +1,100 @@
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an SSMClientAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+// Decrypted parameter values are never cached.
+type cachedClient struct {
+	SSMClientAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client SSMClientAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{SSMClientAPI: client, cache: awsutil.NewResponseCache(cfg, "ssm")}
+}
+
+func (c *cachedClient) GetParameter(ctx context.Context, params *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	if aws.ToBool(params.WithDecryption) {
+		return c.SSMClientAPI.GetParameter(ctx, params, optFns...)
+	}
+	return awsutil.Cached(c.cache, "GetParameter", params, func() (*ssm.GetParameterOutput, error) {
+		return c.SSMClientAPI.GetParameter(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) GetParameters(ctx context.Context, params *ssm.GetParametersInput, optFns ...func(*ssm.Options)) (*ssm.GetParametersOutput, error) {
+	if aws.ToBool(params.WithDecryption) {
+		return c.SSMClientAPI.GetParameters(ctx, params, optFns...)
+	}
+	return awsutil.Cached(c.cache, "GetParameters", params, func() (*ssm.GetParametersOutput, error) {
+		return c.SSMClientAPI.GetParameters(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) GetParametersByPath(ctx context.Context, params *ssm.GetParametersByPathInput, optFns ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error) {
+	if aws.ToBool(params.WithDecryption) {
+		return c.SSMClientAPI.GetParametersByPath(ctx, params, optFns...)
+	}
+	return awsutil.Cached(c.cache, "GetParametersByPath", params, func() (*ssm.GetParametersByPathOutput, error) {
+		return c.SSMClientAPI.GetParametersByPath(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) GetParameterHistory(ctx context.Context, params *ssm.GetParameterHistoryInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error) {
+	if aws.ToBool(params.WithDecryption) {
+		return c.SSMClientAPI.GetParameterHistory(ctx, params, optFns...)
+	}
+	return awsutil.Cached(c.cache, "GetParameterHistory", params, func() (*ssm.GetParameterHistoryOutput, error) {
+		return c.SSMClientAPI.GetParameterHistory(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeParameters(ctx context.Context, params *ssm.DescribeParametersInput, optFns ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeParameters", params, func() (*ssm.DescribeParametersOutput, error) {
+		return c.SSMClientAPI.DescribeParameters(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) PutParameter(ctx context.Context, params *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	defer c.cache.Invalidate()
+	return c.SSMClientAPI.PutParameter(ctx, params, optFns...)
+}
+
+func (c *cachedClient) DeleteParameter(ctx context.Context, params *ssm.DeleteParameterInput, optFns ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error) {
+	defer c.cache.Invalidate()
+	return c.SSMClientAPI.DeleteParameter(ctx, params, optFns...)
+}
+
+func (c *cachedClient) DeleteParameters(ctx context.Context, params *ssm.DeleteParametersInput, optFns ...func(*ssm.Options)) (*ssm.DeleteParametersOutput, error) {
+	defer c.cache.Invalidate()
+	return c.SSMClientAPI.DeleteParameters(ctx, params, optFns...)
+}
+
+func (c *cachedClient) LabelParameterVersion(ctx context.Context, params *ssm.LabelParameterVersionInput, optFns ...func(*ssm.Options)) (*ssm.LabelParameterVersionOutput, error) {
+	defer c.cache.Invalidate()
+	return c.SSMClientAPI.LabelParameterVersion(ctx, params, optFns...)
+}
+
+func (c *cachedClient) UnlabelParameterVersion(ctx context.Context, params *ssm.UnlabelParameterVersionInput, optFns ...func(*ssm.Options)) (*ssm.UnlabelParameterVersionOutput, error) {
+	defer c.cache.Invalidate()
+	return c.SSMClientAPI.UnlabelParameterVersion(ctx, params, optFns...)
+}
+
+func (c *cachedClient) AddTagsToResource(ctx context.Context, params *ssm.AddTagsToResourceInput, optFns ...func(*ssm.Options)) (*ssm.AddTagsToResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.SSMClientAPI.AddTagsToResource(ctx, params, optFns...)
+}
+
+func (c *cachedClient) RemoveTagsFromResource(ctx context.Context, params *ssm.RemoveTagsFromResourceInput, optFns ...func(*ssm.Options)) (*ssm.RemoveTagsFromResourceOutput, error) {
+	defer c.cache.Invalidate()
+	return c.SSMClientAPI.RemoveTagsFromResource(ctx, params, optFns...)
+}
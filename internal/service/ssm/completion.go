@@ -0,0 +1,44 @@
+package ssm
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// CompleteParameterPath returns the parameter names and sub-paths directly under parent, for
+// shell completion one path segment at a time. Sub-paths end in "/".
+func (svc *SSMService) CompleteParameterPath(ctx context.Context, parent string) ([]string, error) {
+	metadata, err := svc.DescribeParameters(ctx, strings.TrimSuffix(parent, "/"))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(metadata))
+	for _, m := range metadata {
+		names = append(names, aws.ToString(m.Name))
+	}
+	return NextPathSegments(parent, names), nil
+}
+
+// NextPathSegments returns, for the names under parent, either the name itself if it is directly
+// under parent, or the sub-path of parent that contains it, ending in "/". The result is sorted
+// and has no duplicates.
+//
+//	NextPathSegments("/app/", ["/app/key", "/app/prod/db", "/app/prod/url"]) = ["/app/key", "/app/prod/"]
+func NextPathSegments(parent string, names []string) []string {
+	var segments []string
+	for _, name := range names {
+		rest, ok := strings.CutPrefix(name, parent)
+		if !ok || rest == "" {
+			continue
+		}
+		if i := strings.Index(rest, "/"); i >= 0 {
+			name = parent + rest[:i+1]
+		}
+		segments = append(segments, name)
+	}
+	slices.Sort(segments)
+	return slices.Compact(segments)
+}
@@ -0,0 +1,27 @@
+package ssm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextPathSegments(t *testing.T) {
+	names := []string{"/app/prod/db", "/app/key", "/app/prod/url", "/other/key", "plain"}
+
+	tests := []struct {
+		parent string
+		want   []string
+	}{
+		{parent: "", want: []string{"/", "plain"}},
+		{parent: "/", want: []string{"/app/", "/other/"}},
+		{parent: "/app/", want: []string{"/app/key", "/app/prod/"}},
+		{parent: "/app/prod/", want: []string{"/app/prod/db", "/app/prod/url"}},
+		{parent: "/missing/", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.parent, func(t *testing.T) {
+			assert.Equal(t, tt.want, NextPathSegments(tt.parent, names))
+		})
+	}
+}
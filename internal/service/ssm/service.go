@@ -25,6 +25,9 @@ type SSMClientAPI interface {
 	DescribeParameters(ctx context.Context, params *ssm.DescribeParametersInput, optFns ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error)
 	LabelParameterVersion(ctx context.Context, params *ssm.LabelParameterVersionInput, optFns ...func(*ssm.Options)) (*ssm.LabelParameterVersionOutput, error)
 	UnlabelParameterVersion(ctx context.Context, params *ssm.UnlabelParameterVersionInput, optFns ...func(*ssm.Options)) (*ssm.UnlabelParameterVersionOutput, error)
+	AddTagsToResource(ctx context.Context, params *ssm.AddTagsToResourceInput, optFns ...func(*ssm.Options)) (*ssm.AddTagsToResourceOutput, error)
+	RemoveTagsFromResource(ctx context.Context, params *ssm.RemoveTagsFromResourceInput, optFns ...func(*ssm.Options)) (*ssm.RemoveTagsFromResourceOutput, error)
+	ListTagsForResource(ctx context.Context, params *ssm.ListTagsForResourceInput, optFns ...func(*ssm.Options)) (*ssm.ListTagsForResourceOutput, error)
 }
 
 // SSMService is a struct that holds the SSM client.
@@ -38,9 +41,9 @@ func NewSSMService(ctx context.Context, profile string, region string) (*SSMServ
 	if err != nil {
 		return nil, err
 	}
-	client := ssm.NewFromConfig(cfg.Config)
+	client := ssm.NewFromConfig(cfg.ServiceConfig("ssm"))
 
-	return &SSMService{Client: client}, nil
+	return &SSMService{Client: newCachedClient(client, cfg)}, nil
 }
 
 // GetParameter fetches a single SSM parameter.
@@ -0,0 +1,20 @@
+package ssm
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// ParameterState returns the version and type of a parameter, for recording in the journal.
+// Values are not recorded, as they may be secret; the version is enough to restore them. A nil
+// parameter, one that does not exist, returns an empty state.
+func ParameterState(param *types.Parameter) map[string]string {
+	if param == nil {
+		return map[string]string{}
+	}
+	return map[string]string{
+		"version": strconv.FormatInt(param.Version, 10),
+		"type":    string(param.Type),
+	}
+}
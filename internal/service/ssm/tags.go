@@ -0,0 +1,51 @@
+package ssm
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// TagParameter adds or overwrites tags on a parameter.
+func (svc *SSMService) TagParameter(ctx context.Context, name string, tags map[string]string) error {
+	var ssmTags []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		ssmTags = append(ssmTags, types.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	_, err := svc.Client.AddTagsToResource(ctx, &ssm.AddTagsToResourceInput{
+		ResourceId:   aws.String(name),
+		ResourceType: types.ResourceTypeForTaggingParameter,
+		Tags:         ssmTags,
+	})
+	return err
+}
+
+// UntagParameter removes the tags with the given keys from a parameter.
+func (svc *SSMService) UntagParameter(ctx context.Context, name string, keys []string) error {
+	_, err := svc.Client.RemoveTagsFromResource(ctx, &ssm.RemoveTagsFromResourceInput{
+		ResourceId:   aws.String(name),
+		ResourceType: types.ResourceTypeForTaggingParameter,
+		TagKeys:      keys,
+	})
+	return err
+}
+
+// GetParameterTags returns the tags of a parameter.
+func (svc *SSMService) GetParameterTags(ctx context.Context, name string) (map[string]string, error) {
+	output, err := svc.Client.ListTagsForResource(ctx, &ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(name),
+		ResourceType: types.ResourceTypeForTaggingParameter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(output.TagList))
+	for _, tag := range output.TagList {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
+}
@@ -0,0 +1,69 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+)
+
+// cachedClient is an VPCAPI that caches the responses of read-only calls for
+// awsutil.CacheTTL, and clears the cache after calls that change resources.
+type cachedClient struct {
+	VPCAPI
+	cache *awsutil.ResponseCache
+}
+
+// newCachedClient wraps client in a cachedClient for the profile and region of cfg.
+func newCachedClient(client VPCAPI, cfg *awsutil.BaseService) *cachedClient {
+	return &cachedClient{VPCAPI: client, cache: awsutil.NewResponseCache(cfg, "ec2")}
+}
+
+func (c *cachedClient) DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeVpcs", params, func() (*ec2.DescribeVpcsOutput, error) {
+		return c.VPCAPI.DescribeVpcs(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeNetworkAcls(ctx context.Context, params *ec2.DescribeNetworkAclsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeNetworkAcls", params, func() (*ec2.DescribeNetworkAclsOutput, error) {
+		return c.VPCAPI.DescribeNetworkAcls(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeNatGateways(ctx context.Context, params *ec2.DescribeNatGatewaysInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeNatGateways", params, func() (*ec2.DescribeNatGatewaysOutput, error) {
+		return c.VPCAPI.DescribeNatGateways(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribePrefixLists(ctx context.Context, params *ec2.DescribePrefixListsInput, optFns ...func(*ec2.Options)) (*ec2.DescribePrefixListsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribePrefixLists", params, func() (*ec2.DescribePrefixListsOutput, error) {
+		return c.VPCAPI.DescribePrefixLists(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeManagedPrefixLists(ctx context.Context, params *ec2.DescribeManagedPrefixListsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeManagedPrefixLists", params, func() (*ec2.DescribeManagedPrefixListsOutput, error) {
+		return c.VPCAPI.DescribeManagedPrefixLists(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeRouteTables(ctx context.Context, params *ec2.DescribeRouteTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeRouteTables", params, func() (*ec2.DescribeRouteTablesOutput, error) {
+		return c.VPCAPI.DescribeRouteTables(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeSubnets(ctx context.Context, params *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeSubnets", params, func() (*ec2.DescribeSubnetsOutput, error) {
+		return c.VPCAPI.DescribeSubnets(ctx, params, optFns...)
+	})
+}
+
+func (c *cachedClient) DescribeInternetGateways(ctx context.Context, params *ec2.DescribeInternetGatewaysInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInternetGatewaysOutput, error) {
+	return awsutil.Cached(c.cache, "DescribeInternetGateways", params, func() (*ec2.DescribeInternetGatewaysOutput, error) {
+		return c.VPCAPI.DescribeInternetGateways(ctx, params, optFns...)
+	})
+}
@@ -31,31 +31,28 @@ func NewVPCService(ctx context.Context, profile string, region string) (*VPCServ
 		return nil, err
 	}
 
-	client := ec2.NewFromConfig(cfg.Config)
+	client := ec2.NewFromConfig(cfg.ServiceConfig("ec2"))
 
 	return &VPCService{
-		Client: client,
+		Client: newCachedClient(client, cfg),
 	}, nil
 }
 
 func (svc *VPCService) GetVPCs(ctx context.Context, input *ascTypes.GetVPCsInput) ([]types.Vpc, error) {
-	output, err := svc.Client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{})
-	if err != nil {
-		return nil, err
-	}
-
-	return output.Vpcs, nil
+	paginator := ec2.NewDescribeVpcsPaginator(svc.Client, &ec2.DescribeVpcsInput{})
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeVpcsOutput) []types.Vpc {
+		return page.Vpcs
+	})
 }
 
 // GetNACLs fetches Network ACLs from AWS.
 func (svc *VPCService) GetNACLs(ctx context.Context, input *ascTypes.GetNACLsInput) ([]types.NetworkAcl, error) {
-	output, err := svc.Client.DescribeNetworkAcls(ctx, &ec2.DescribeNetworkAclsInput{
+	paginator := ec2.NewDescribeNetworkAclsPaginator(svc.Client, &ec2.DescribeNetworkAclsInput{
 		NetworkAclIds: input.NetworkAclIds,
 	})
-	if err != nil {
-		return nil, err
-	}
-	return output.NetworkAcls, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeNetworkAclsOutput) []types.NetworkAcl {
+		return page.NetworkAcls
+	})
 }
 
 // GetNatGateways fetches NAT Gateways from AWS.
@@ -67,22 +64,20 @@ func (svc *VPCService) GetNatGateways(ctx context.Context, input *ascTypes.GetNa
 		describeInput.NatGatewayIds = input.NatGatewayIds
 	}
 
-	output, err := svc.Client.DescribeNatGateways(ctx, describeInput)
-	if err != nil {
-		return nil, err
-	}
-	return output.NatGateways, nil
+	paginator := ec2.NewDescribeNatGatewaysPaginator(svc.Client, describeInput)
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeNatGatewaysOutput) []types.NatGateway {
+		return page.NatGateways
+	})
 }
 
 // GetPrefixLists fetches Prefix Lists from AWS.
 func (svc *VPCService) GetPrefixLists(ctx context.Context, input *ascTypes.GetPrefixListsInput) ([]types.PrefixList, error) {
-	output, err := svc.Client.DescribePrefixLists(ctx, &ec2.DescribePrefixListsInput{
+	paginator := ec2.NewDescribePrefixListsPaginator(svc.Client, &ec2.DescribePrefixListsInput{
 		PrefixListIds: input.PrefixListIds,
 	})
-	if err != nil {
-		return nil, err
-	}
-	return output.PrefixLists, nil
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribePrefixListsOutput) []types.PrefixList {
+		return page.PrefixLists
+	})
 }
 
 // GetManagedPrefixLists fetches Managed Prefix Lists from AWS.
@@ -94,11 +89,10 @@ func (svc *VPCService) GetManagedPrefixLists(ctx context.Context, input *ascType
 		describeInput.PrefixListIds = input.PrefixListIds
 	}
 
-	output, err := svc.Client.DescribeManagedPrefixLists(ctx, describeInput)
-	if err != nil {
-		return nil, err
-	}
-	return output.PrefixLists, nil
+	paginator := ec2.NewDescribeManagedPrefixListsPaginator(svc.Client, describeInput)
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeManagedPrefixListsOutput) []types.ManagedPrefixList {
+		return page.PrefixLists
+	})
 }
 
 // GetRouteTables fetches Route Tables from AWS.
@@ -110,11 +104,10 @@ func (svc *VPCService) GetRouteTables(ctx context.Context, input *ascTypes.GetRo
 		describeInput.RouteTableIds = input.RouteTableIds
 	}
 
-	output, err := svc.Client.DescribeRouteTables(ctx, describeInput)
-	if err != nil {
-		return nil, err
-	}
-	return output.RouteTables, nil
+	paginator := ec2.NewDescribeRouteTablesPaginator(svc.Client, describeInput)
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeRouteTablesOutput) []types.RouteTable {
+		return page.RouteTables
+	})
 }
 
 // GetSubnets fetches Subnets from AWS.
@@ -137,11 +130,10 @@ func (svc *VPCService) GetSubnets(ctx context.Context, input *ascTypes.GetSubnet
 		describeInput.Filters = nil // Clear filters when using specific IDs
 	}
 
-	output, err := svc.Client.DescribeSubnets(ctx, describeInput)
-	if err != nil {
-		return nil, err
-	}
-	return output.Subnets, nil
+	paginator := ec2.NewDescribeSubnetsPaginator(svc.Client, describeInput)
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeSubnetsOutput) []types.Subnet {
+		return page.Subnets
+	})
 }
 
 // GetIGWs fetches Internet Gateways from AWS.
@@ -153,11 +145,10 @@ func (svc *VPCService) GetIGWs(ctx context.Context, input *ascTypes.GetIGWsInput
 		describeInput.InternetGatewayIds = input.IGWIds
 	}
 
-	output, err := svc.Client.DescribeInternetGateways(ctx, describeInput)
-	if err != nil {
-		return nil, err
-	}
-	return output.InternetGateways, nil
+	paginator := ec2.NewDescribeInternetGatewaysPaginator(svc.Client, describeInput)
+	return awsutil.CollectPages(ctx, paginator, func(page *ec2.DescribeInternetGatewaysOutput) []types.InternetGateway {
+		return page.InternetGateways
+	})
 }
 
 // FilterNACLRules fetches Network ACL Rules from AWS.
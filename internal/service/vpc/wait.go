@@ -20,6 +20,11 @@ func IsTerminalNatGatewayState(status string) bool {
 	return natGatewayTerminalStates[strings.ToLower(status)]
 }
 
+// IsFailedNatGatewayState returns true if the NAT gateway state is a failure.
+func IsFailedNatGatewayState(status string) bool {
+	return strings.EqualFold(status, "failed")
+}
+
 // GetNatGatewayStatus returns the current state of a NAT gateway.
 func (svc *VPCService) GetNatGatewayStatus(ctx context.Context, natGatewayID string) (string, error) {
 	output, err := svc.Client.DescribeNatGateways(ctx, &ec2sdk.DescribeNatGatewaysInput{
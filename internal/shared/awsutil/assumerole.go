@@ -0,0 +1,119 @@
+package awsutil
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/harleymckenzie/asc/internal/shared/cache"
+)
+
+const (
+	// AssumeRoleMinDuration and AssumeRoleMaxDuration are the shortest and longest role sessions
+	// AWS allows.
+	AssumeRoleMinDuration = 15 * time.Minute
+	AssumeRoleMaxDuration = 12 * time.Hour
+	// sessionExpiryWindow is how long before it expires a cached session is replaced, so that it
+	// does not expire during a command.
+	sessionExpiryWindow = 5 * time.Minute
+)
+
+// AssumeRoleOptions are the role that every AWS service is called with, assumed using the
+// credentials of the profile.
+type AssumeRoleOptions struct {
+	RoleARN    string        // Role to assume. Empty uses the profile's credentials directly
+	ExternalID string        // External ID required by the role's trust policy, if any
+	MFASerial  string        // ARN or serial number of the MFA device, if the role requires MFA
+	Duration   time.Duration // Duration of the role session
+}
+
+// AssumeRole is the role set by the global --role-arn flag and related flags.
+var AssumeRole AssumeRoleOptions
+
+// PromptMFAToken asks for the current MFA token code. It is replaced where there is no terminal
+// to prompt in, such as during shell completion.
+var PromptMFAToken = func(serial string) (string, error) {
+	fmt.Fprintf(os.Stderr, "MFA token code for %s: ", serial)
+	token, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("read MFA token code: %w", err)
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// assumeRoleMu serialises role sessions, so that concurrent clients prompt for the MFA token
+// code once and then share the cached session.
+var assumeRoleMu sync.Mutex
+
+// assumeRoleProvider returns credentials for a role session, cached on disk until shortly
+// before they expire so that later commands reuse the session without prompting for MFA again.
+type assumeRoleProvider struct {
+	key      string
+	provider aws.CredentialsProvider
+}
+
+// assumeRoleCredentials returns a provider of credentials for the role in AssumeRole, assumed
+// with the credentials in cfg.
+func assumeRoleCredentials(cfg aws.Config, profile string) aws.CredentialsProvider {
+	opts := AssumeRole
+	stsConfig := cfg.Copy()
+	if endpoint := Endpoint("sts"); endpoint != "" {
+		stsConfig.BaseEndpoint = aws.String(endpoint)
+	}
+	if stsConfig.Region == "" {
+		stsConfig.Region = defaultRegion
+	}
+
+	provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(stsConfig), opts.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = fmt.Sprintf("asc-%d", time.Now().Unix())
+		if opts.Duration > 0 {
+			o.Duration = opts.Duration
+		}
+		if opts.ExternalID != "" {
+			o.ExternalID = aws.String(opts.ExternalID)
+		}
+		if opts.MFASerial != "" {
+			o.SerialNumber = aws.String(opts.MFASerial)
+			o.TokenProvider = func() (string, error) {
+				return PromptMFAToken(opts.MFASerial)
+			}
+		}
+	})
+	key := strings.Join([]string{
+		cmp.Or(profile, os.Getenv("AWS_PROFILE"), "default"), opts.RoleARN, opts.ExternalID, opts.MFASerial,
+	}, "\x00")
+	return aws.NewCredentialsCache(&assumeRoleProvider{key: key, provider: provider})
+}
+
+// Retrieve returns the cached role session if it has not expired, and otherwise assumes the role
+// and caches the new session.
+func (p *assumeRoleProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	assumeRoleMu.Lock()
+	defer assumeRoleMu.Unlock()
+
+	sessions, err := cache.New("sessions")
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	var creds aws.Credentials
+	if sessions.Get(p.key, AssumeRoleMaxDuration, &creds) && time.Until(creds.Expires) > sessionExpiryWindow {
+		return creds, nil
+	}
+
+	creds, err = p.provider.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("assume role: %w", err)
+	}
+	// A session that cannot be cached is assumed again by the next command
+	_ = sessions.Put(p.key, creds)
+	return creds, nil
+}
@@ -0,0 +1,53 @@
+package awsutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssumeRoleProviderCachesSession(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	calls := 0
+	expires := time.Now().Add(time.Hour)
+	newProvider := func(key string) *assumeRoleProvider {
+		return &assumeRoleProvider{key: key, provider: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			calls++
+			return aws.Credentials{AccessKeyID: "ASIA1", SecretAccessKey: "secret", SessionToken: "token", CanExpire: true, Expires: expires}, nil
+		})}
+	}
+
+	creds, err := newProvider("prod/role").Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ASIA1", creds.AccessKeyID)
+
+	// A later command reuses the session from disk
+	creds, err = newProvider("prod/role").Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token", creds.SessionToken)
+	assert.Equal(t, 1, calls)
+
+	// Another role or profile has its own session
+	_, err = newProvider("staging/role").Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAssumeRoleProviderRenewsExpiringSession(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	calls := 0
+	provider := &assumeRoleProvider{key: "prod/role", provider: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		calls++
+		return aws.Credentials{AccessKeyID: "ASIA1", CanExpire: true, Expires: time.Now().Add(time.Minute)}, nil
+	})}
+
+	_, err := provider.Retrieve(context.Background())
+	require.NoError(t, err)
+	_, err = provider.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
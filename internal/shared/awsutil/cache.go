@@ -0,0 +1,86 @@
+package awsutil
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/cache"
+)
+
+// CacheTTL is how long the responses to read-only calls are cached on disk. Zero disables the
+// cache, although calls that change resources still clear it.
+var CacheTTL time.Duration
+
+// ResponseCache caches the responses of read-only calls to one AWS service in a profile and
+// region. Each service's client is wrapped in a cachedClient that reads calls such as
+// DescribeInstances through Cached, and calls Invalidate after calls that change resources.
+type ResponseCache struct {
+	cache *cache.Cache
+}
+
+// NewResponseCache returns the response cache for an AWS service, such as "ec2", in the profile
+// and region of cfg.
+func NewResponseCache(cfg *BaseService, service string) *ResponseCache {
+	profile := cmp.Or(cfg.Profile, os.Getenv("AWS_PROFILE"), "default")
+	dir := filepath.Join("responses", url.PathEscape(profile), url.PathEscape(cfg.Config.Region))
+	// An assumed role may be in another account than the profile
+	if AssumeRole.RoleARN != "" {
+		dir = filepath.Join(dir, url.PathEscape(AssumeRole.RoleARN))
+	}
+	// Responses from another endpoint, such as LocalStack, are kept apart from those from AWS
+	if endpoint := Endpoint(service); endpoint != "" {
+		dir = filepath.Join(dir, url.PathEscape(endpoint))
+	}
+	c, err := cache.New(filepath.Join(dir, service))
+	if err != nil {
+		return &ResponseCache{}
+	}
+	return &ResponseCache{cache: c}
+}
+
+// Cached returns the cached response to the operation with the given input if it is newer than
+// CacheTTL, and otherwise makes the call and caches its response. Errors are not cached.
+func Cached[Out any](c *ResponseCache, operation string, input any, call func() (Out, error)) (Out, error) {
+	if c == nil || c.cache == nil || CacheTTL <= 0 {
+		return call()
+	}
+	key, err := json.Marshal(struct {
+		Operation string
+		Input     any
+	}{operation, input})
+	if err != nil {
+		return call()
+	}
+
+	var out Out
+	if c.cache.Get(string(key), CacheTTL, &out) {
+		return out, nil
+	}
+	out, err = call()
+	if err == nil {
+		// A response that cannot be cached is fetched again next time
+		_ = c.cache.Put(string(key), out)
+	}
+	return out, err
+}
+
+// Invalidate clears the cached responses of the service, after a call that may have changed its
+// resources.
+func (c *ResponseCache) Invalidate() {
+	if c != nil && c.cache != nil {
+		_ = c.cache.Clear()
+	}
+}
+
+// ClearCache removes every cached response, completion and role session.
+func ClearCache() error {
+	c, err := cache.New("")
+	if err != nil {
+		return err
+	}
+	return c.Clear()
+}
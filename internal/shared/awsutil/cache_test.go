@@ -0,0 +1,85 @@
+package awsutil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cacheTestOutput struct {
+	Names []string
+}
+
+// newCacheTest returns a response cache in a temporary directory, and a call that counts how
+// often it is made.
+func newCacheTest(t *testing.T, ttl time.Duration) (*ResponseCache, func() (*cacheTestOutput, error), *int) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	CacheTTL = ttl
+	t.Cleanup(func() { CacheTTL = 0 })
+
+	calls := 0
+	call := func() (*cacheTestOutput, error) {
+		calls++
+		return &cacheTestOutput{Names: []string{"web", "api"}}, nil
+	}
+	cfg := &BaseService{Config: aws.Config{Region: "eu-west-1"}, Profile: "prod"}
+	return NewResponseCache(cfg, "ec2"), call, &calls
+}
+
+func TestCached(t *testing.T) {
+	c, call, calls := newCacheTest(t, time.Minute)
+	input := struct{ Filter string }{"web"}
+
+	out, err := Cached(c, "DescribeInstances", input, call)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web", "api"}, out.Names)
+
+	out, err = Cached(c, "DescribeInstances", input, call)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web", "api"}, out.Names)
+	assert.Equal(t, 1, *calls)
+
+	// A different input or operation is cached separately
+	_, _ = Cached(c, "DescribeInstances", struct{ Filter string }{"api"}, call)
+	_, _ = Cached(c, "DescribeVolumes", input, call)
+	assert.Equal(t, 3, *calls)
+
+	// Another profile or region does not share the cache
+	other := NewResponseCache(&BaseService{Config: aws.Config{Region: "us-east-1"}, Profile: "prod"}, "ec2")
+	_, _ = Cached(other, "DescribeInstances", input, call)
+	assert.Equal(t, 4, *calls)
+}
+
+func TestCachedDisabled(t *testing.T) {
+	c, call, calls := newCacheTest(t, 0)
+
+	_, _ = Cached(c, "DescribeInstances", nil, call)
+	_, _ = Cached(c, "DescribeInstances", nil, call)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestCachedErrors(t *testing.T) {
+	c, call, calls := newCacheTest(t, time.Minute)
+	failing := func() (*cacheTestOutput, error) {
+		return nil, errors.New("throttled")
+	}
+
+	_, err := Cached(c, "DescribeInstances", nil, failing)
+	assert.Error(t, err)
+	_, err = Cached(c, "DescribeInstances", nil, call)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestInvalidate(t *testing.T) {
+	c, call, calls := newCacheTest(t, time.Minute)
+
+	_, _ = Cached(c, "DescribeInstances", nil, call)
+	c.Invalidate()
+	_, _ = Cached(c, "DescribeInstances", nil, call)
+	assert.Equal(t, 2, *calls)
+}
@@ -0,0 +1,75 @@
+package awsutil
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// EndpointURL is the endpoint every AWS service is called at, from --endpoint-url or
+// ASC_ENDPOINT_URL, such as http://localhost:4566 for LocalStack. Empty uses the AWS endpoints.
+var EndpointURL string
+
+// ServiceEndpoints are the endpoints of individual services, keyed by service name, from the
+// endpoints section of the configuration file. EndpointURL takes precedence over them.
+var ServiceEndpoints map[string]string
+
+// EndpointServices are the service names that endpoints can be set for, as used in ARNs and IAM
+// actions.
+var EndpointServices = []string{
+	"autoscaling",
+	"cloudformation",
+	"ec2",
+	"ecs",
+	"elasticache",
+	"elasticfilesystem",
+	"elasticloadbalancing",
+	"organizations",
+	"rds",
+	"ssm",
+	"sts",
+}
+
+// Endpoint returns the endpoint to call a service at, or an empty string for the AWS endpoint.
+func Endpoint(service string) string {
+	if EndpointURL != "" {
+		return EndpointURL
+	}
+	return ServiceEndpoints[service]
+}
+
+// ServiceConfig returns the configuration for a client of a service, such as "ec2", calling the
+// endpoint set for the service, if any.
+func (s *BaseService) ServiceConfig(service string) aws.Config {
+	cfg := s.Config.Copy()
+	if endpoint := Endpoint(service); endpoint != "" {
+		cfg.BaseEndpoint = aws.String(endpoint)
+	}
+	return cfg
+}
+
+// ValidateEndpoint returns an error if endpoint is not an absolute http or https URL.
+func ValidateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid endpoint URL %q. Must be an http or https URL, e.g. http://localhost:4566", endpoint)
+	}
+	return nil
+}
+
+// ValidateServiceEndpoints returns an error if an endpoint is set for an unknown service, or is
+// not a valid URL.
+func ValidateServiceEndpoints(endpoints map[string]string) error {
+	for service, endpoint := range endpoints {
+		if !slices.Contains(EndpointServices, service) {
+			return fmt.Errorf("unknown service %q. Must be one of: %s", service, strings.Join(EndpointServices, ", "))
+		}
+		if err := ValidateEndpoint(endpoint); err != nil {
+			return fmt.Errorf("%s: %w", service, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package awsutil
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceConfig(t *testing.T) {
+	t.Cleanup(func() {
+		EndpointURL = ""
+		ServiceEndpoints = nil
+	})
+	base := &BaseService{Config: aws.Config{Region: "eu-west-1"}}
+
+	assert.Nil(t, base.ServiceConfig("ec2").BaseEndpoint)
+
+	ServiceEndpoints = map[string]string{"ssm": "http://localhost:5000"}
+	assert.Equal(t, "http://localhost:5000", aws.ToString(base.ServiceConfig("ssm").BaseEndpoint))
+	assert.Nil(t, base.ServiceConfig("ec2").BaseEndpoint)
+
+	// The global endpoint takes precedence, and the base configuration is left alone
+	EndpointURL = "http://localhost:4566"
+	assert.Equal(t, "http://localhost:4566", aws.ToString(base.ServiceConfig("ssm").BaseEndpoint))
+	assert.Equal(t, "http://localhost:4566", aws.ToString(base.ServiceConfig("ec2").BaseEndpoint))
+	assert.Nil(t, base.Config.BaseEndpoint)
+}
+
+func TestValidateServiceEndpoints(t *testing.T) {
+	assert.NoError(t, ValidateServiceEndpoints(map[string]string{"ec2": "http://localhost:4566", "ssm": "https://ssm.internal"}))
+	assert.Error(t, ValidateServiceEndpoints(map[string]string{"s3": "http://localhost:4566"}))
+	assert.Error(t, ValidateServiceEndpoints(map[string]string{"ec2": "localhost:4566"}))
+	assert.Error(t, ValidateServiceEndpoints(map[string]string{"ec2": "ftp://localhost"}))
+}
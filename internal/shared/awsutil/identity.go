@@ -0,0 +1,25 @@
+package awsutil
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// GetAccountID returns the ID of the account that the profile's credentials belong to.
+func GetAccountID(ctx context.Context, profile string, region string) (string, error) {
+	cfg, err := LoadDefaultConfig(ctx, profile, region)
+	if err != nil {
+		return "", err
+	}
+	if cfg.Config.Region == "" {
+		cfg.Config.Region = defaultRegion
+	}
+
+	output, err := sts.NewFromConfig(cfg.ServiceConfig("sts")).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(output.Account), nil
+}
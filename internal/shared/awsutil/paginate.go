@@ -0,0 +1,26 @@
+package awsutil
+
+import (
+	"context"
+)
+
+// Paginator is implemented by the SDK paginators, such as ec2.DescribeInstancesPaginator.
+type Paginator[O any, Opts any] interface {
+	HasMorePages() bool
+	NextPage(ctx context.Context, optFns ...func(*Opts)) (O, error)
+}
+
+// CollectPages reads every page from the paginator and returns the items extracted from each
+// page. Lists are capped by --max-items when they are rendered, not here, so that walks over
+// several lists, such as the services of each cluster, are complete.
+func CollectPages[T any, O any, Opts any](ctx context.Context, paginator Paginator[O, Opts], items func(O) []T) ([]T, error) {
+	var all []T
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items(page)...)
+	}
+	return all, nil
+}
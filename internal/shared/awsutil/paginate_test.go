@@ -0,0 +1,40 @@
+package awsutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeOptions struct{}
+
+// fakePaginator returns each of its pages in turn.
+type fakePaginator struct {
+	pages [][]string
+}
+
+func (p *fakePaginator) HasMorePages() bool {
+	return len(p.pages) > 0
+}
+
+func (p *fakePaginator) NextPage(ctx context.Context, optFns ...func(*fakeOptions)) ([]string, error) {
+	page := p.pages[0]
+	p.pages = p.pages[1:]
+	return page, nil
+}
+
+func collect(t *testing.T, pages [][]string) []string {
+	items, err := CollectPages(context.Background(), &fakePaginator{pages: pages}, func(page []string) []string {
+		return page
+	})
+	assert.NoError(t, err)
+	return items
+}
+
+// Unit test for CollectPages
+func TestCollectPages(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, collect(t, [][]string{{"a", "b"}, {"c"}}))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, collect(t, [][]string{{"a"}, {"b", "c"}, {"d"}}))
+	assert.Empty(t, collect(t, nil))
+}
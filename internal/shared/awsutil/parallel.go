@@ -0,0 +1,69 @@
+package awsutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// DefaultConcurrency is the default number of calls made at a time by ParallelMap.
+const DefaultConcurrency = 8
+
+// ParallelMap calls fn for each item using a pool of up to concurrency workers (DefaultConcurrency
+// if 0 or less), and returns the results in the order of items. If a call fails, the calls not
+// yet started are skipped and the calls in progress are cancelled. The first error in the order
+// of items is returned, ignoring the cancellations it caused.
+func ParallelMap[T, R any](ctx context.Context, concurrency int, items []T, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]R, len(items))
+	errs := make([]error, len(items))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], errs[i] = fn(ctx, items[i])
+				if errs[i] != nil {
+					cancel()
+				}
+			}
+		}()
+	}
+
+send:
+	for i := range items {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			break send
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Report the failure that caused the others to be cancelled, rather than a cancellation
+	var first error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	if first != nil {
+		return nil, first
+	}
+	return results, nil
+}
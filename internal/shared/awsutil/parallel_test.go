@@ -0,0 +1,41 @@
+package awsutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelMap(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	results, err := ParallelMap(context.Background(), 3, items, func(ctx context.Context, n int) (int, error) {
+		return n * n, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 4, 9, 16, 25, 36, 49, 64, 81, 100}, results)
+
+	results, err = ParallelMap(context.Background(), 0, []int(nil), func(ctx context.Context, n int) (int, error) {
+		return n, nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestParallelMapError(t *testing.T) {
+	failed := errors.New("throttled")
+	items := []int{1, 2, 3, 4}
+	_, err := ParallelMap(context.Background(), 2, items, func(ctx context.Context, n int) (int, error) {
+		if n == 2 {
+			return 0, failed
+		}
+		// Calls still in progress are cancelled by the failure
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	// The failure is returned rather than the cancellation of the earlier item
+	assert.ErrorIs(t, err, failed)
+	assert.NotErrorIs(t, err, context.Canceled)
+}
@@ -9,10 +9,10 @@ import (
 // e.g. "rds://my-database" → {Service: "rds", ResourceType: "instance", Resource: "my-database"}
 // e.g. "ecs://service/my-cluster/my-svc" → {Service: "ecs", ResourceType: "service", Resource: "my-svc", Params: {"cluster": "my-cluster"}}
 type ResourceURI struct {
-	Service      string
-	ResourceType string
-	Resource     string
-	Params       map[string]string
+	Service      string            `json:"service"`
+	ResourceType string            `json:"resource_type"`
+	Resource     string            `json:"resource"`
+	Params       map[string]string `json:"params,omitempty"`
 }
 
 // resourceTypeConfig defines the path parameters for a resource type.
@@ -34,20 +34,30 @@ type serviceConfig struct {
 }
 
 var services = map[string]serviceConfig{
+	"asg": {
+		DefaultType: "auto-scaling-group",
+		ResourceTypes: map[string]resourceTypeConfig{
+			"auto-scaling-group": {},
+			"schedule":           {PathParams: []string{"group"}},
+		},
+	},
 	"ec2": {
 		DefaultType: "instance",
 		ResourceTypes: map[string]resourceTypeConfig{
-			"instance": {},
-			"volume":   {},
-			"snapshot": {},
-			"image":    {},
+			"instance":       {},
+			"volume":         {},
+			"snapshot":       {},
+			"image":          {},
+			"security-group": {},
 		},
 	},
 	"rds": {
 		DefaultType: "instance",
 		ResourceTypes: map[string]resourceTypeConfig{
-			"instance": {},
-			"cluster":  {},
+			"instance":         {},
+			"cluster":          {},
+			"snapshot":         {},
+			"cluster-snapshot": {},
 		},
 	},
 	"cf": {
@@ -71,14 +81,34 @@ var services = map[string]serviceConfig{
 	"vpc": {
 		DefaultType: "nat-gateway",
 		ResourceTypes: map[string]resourceTypeConfig{
-			"nat-gateway": {},
+			"vpc":              {},
+			"subnet":           {},
+			"internet-gateway": {},
+			"nat-gateway":      {},
+			"route-table":      {},
+			"network-acl":      {},
+			"prefix-list":      {},
 		},
 	},
 	"ecs": {
 		DefaultType: "service",
 		ResourceTypes: map[string]resourceTypeConfig{
-			"service": {PathParams: []string{"cluster"}},
-			"task":    {PathParams: []string{"cluster"}},
+			"cluster":         {},
+			"service":         {PathParams: []string{"cluster"}},
+			"task":            {PathParams: []string{"cluster"}},
+			"task-definition": {},
+		},
+	},
+	"efs": {
+		DefaultType: "file-system",
+		ResourceTypes: map[string]resourceTypeConfig{
+			"file-system": {},
+		},
+	},
+	"ssm": {
+		DefaultType: "parameter",
+		ResourceTypes: map[string]resourceTypeConfig{
+			"parameter": {},
 		},
 	},
 }
@@ -86,6 +116,8 @@ var services = map[string]serviceConfig{
 // ParseResourceURI parses a protocol-style resource identifier.
 //
 // Supported formats:
+//   - "asg://my-asg"                       → Auto Scaling Group
+//   - "asg://schedule/my-asg/my-schedule"  → Auto Scaling Group scheduled action
 //   - "ec2://i-xxx"                        → EC2 instance
 //   - "ec2://volume/vol-xxx"               → EC2 volume
 //   - "rds://my-database"                  → RDS instance (default type)
@@ -96,6 +128,8 @@ var services = map[string]serviceConfig{
 //   - "vpc://nat-gateway/nat-xxx"          → VPC NAT gateway
 //   - "ecs://service/my-cluster/my-svc"    → ECS service (cluster extracted as param)
 //   - "ecs://task/my-cluster/task-id"      → ECS task (cluster extracted as param)
+//   - "efs://fs-xxx"                       → EFS file system
+//   - "ssm:///my/parameter"                → SSM parameter
 //   - "i-xxx"                              → EC2 instance (detected by prefix)
 //   - "nat-xxx"                            → VPC NAT gateway (detected by prefix)
 //
@@ -161,3 +195,14 @@ func ParseResourceURI(input string) (*ResourceURI, error) {
 
 	return nil, fmt.Errorf("cannot determine service for %q: use protocol syntax (e.g. rds://my-database)", input)
 }
+
+// String returns the canonical URI for the resource, including the resource
+// type and any path parameters (e.g. "ecs://service/my-cluster/my-svc").
+func (u *ResourceURI) String() string {
+	parts := []string{u.ResourceType}
+	for _, paramName := range services[u.Service].ResourceTypes[u.ResourceType].PathParams {
+		parts = append(parts, u.Params[paramName])
+	}
+	parts = append(parts, u.Resource)
+	return u.Service + "://" + strings.Join(parts, "/")
+}
@@ -0,0 +1,38 @@
+package awsutil
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// defaultRegion is used to look up the enabled regions when no region is configured.
+const defaultRegion = "us-east-1"
+
+// ListRegions returns the sorted regions enabled for the account, skipping opt-in regions that
+// have not been enabled.
+func ListRegions(ctx context.Context, profile string, region string) ([]string, error) {
+	cfg, err := LoadDefaultConfig(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Config.Region == "" {
+		cfg.Config.Region = defaultRegion
+	}
+
+	output, err := ec2.NewFromConfig(cfg.ServiceConfig("ec2")).DescribeRegions(ctx, &ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []string
+	for _, r := range output.Regions {
+		regions = append(regions, aws.ToString(r.RegionName))
+	}
+	slices.Sort(regions)
+	return regions, nil
+}
@@ -25,6 +25,7 @@ var prefixMap = []struct {
 	{"rtb-", ResourceInfo{Service: "vpc", ResourceType: "route-table"}},
 	{"acl-", ResourceInfo{Service: "vpc", ResourceType: "network-acl"}},
 	{"pl-", ResourceInfo{Service: "vpc", ResourceType: "prefix-list"}},
+	{"fs-", ResourceInfo{Service: "efs", ResourceType: "file-system"}},
 }
 
 // IdentifyResource returns the service and resource type for a given AWS
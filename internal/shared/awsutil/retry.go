@@ -0,0 +1,138 @@
+package awsutil
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// DefaultRateLimit is the default of --rate-limit. It is low enough that listing from many
+// regions or profiles, or describing the ECS services and tasks of many clusters, does not
+// throttle an account, and allows a second's burst, so commands making a few calls are not slowed.
+const DefaultRateLimit = 10
+
+var (
+	// RetryMode is the SDK retry mode, "standard" or "adaptive", from --retry-mode. Empty uses
+	// the SDK's default, which can be set with AWS_RETRY_MODE or in the AWS config.
+	RetryMode string
+	// MaxAttempts is the maximum number of attempts for each call, from --max-attempts. Zero uses
+	// the SDK's default, which can be set with AWS_MAX_ATTEMPTS or in the AWS config.
+	MaxAttempts int
+	// RateLimit is the maximum number of requests per second made to each service in a profile
+	// and region, shared by every client and goroutine, from --rate-limit. Zero leaves requests
+	// unlimited.
+	RateLimit float64
+)
+
+// ParseRetryMode returns an error if mode is not a retry mode supported by the SDK.
+func ParseRetryMode(mode string) error {
+	if _, err := aws.ParseRetryMode(mode); err != nil {
+		return fmt.Errorf("invalid value for retry-mode flag: %s. Must be standard or adaptive", mode)
+	}
+	return nil
+}
+
+// retryOptions returns the options that set the SDK's retry mode and maximum attempts.
+func retryOptions() []func(*config.LoadOptions) error {
+	var opts []func(*config.LoadOptions) error
+	if RetryMode != "" {
+		opts = append(opts, config.WithRetryMode(aws.RetryMode(RetryMode)))
+	}
+	if MaxAttempts > 0 {
+		opts = append(opts, config.WithRetryMaxAttempts(MaxAttempts))
+	}
+	return opts
+}
+
+// rateLimiter spaces requests at a fixed rate, allowing a burst of up to a second's worth of
+// requests after a quiet period.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    int
+	next     time.Time
+}
+
+// newRateLimiter returns a limiter allowing perSecond requests per second.
+func newRateLimiter(perSecond float64) *rateLimiter {
+	return &rateLimiter{
+		interval: time.Duration(float64(time.Second) / perSecond),
+		burst:    max(1, int(perSecond)),
+	}
+}
+
+// Wait blocks until a request may be made, or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	earliest := now.Add(-time.Duration(l.burst-1) * l.interval)
+	if l.next.Before(earliest) {
+		l.next = earliest
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var (
+	rateLimitersMu sync.Mutex
+	rateLimiters   = map[string]*rateLimiter{}
+)
+
+// getRateLimiter returns the limiter shared by the calls to a service in a profile and region.
+func getRateLimiter(key string) *rateLimiter {
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+	l, ok := rateLimiters[key]
+	if !ok {
+		l = newRateLimiter(RateLimit)
+		rateLimiters[key] = l
+	}
+	return l
+}
+
+// addRateLimit returns a function that adds middleware to a client's stack that waits for the
+// shared rate limiter of the call's service, profile and region before each attempt. Concurrent
+// commands, such as those listing from several regions, then cannot throttle the account.
+func addRateLimit(profile string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		if RateLimit <= 0 {
+			return nil
+		}
+		limit := middleware.FinalizeMiddlewareFunc("ascRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			key := strings.Join([]string{
+				cmp.Or(profile, os.Getenv("AWS_PROFILE")), awsmiddleware.GetRegion(ctx), awsmiddleware.GetServiceID(ctx),
+			}, "\x00")
+			if err := getRateLimiter(key).Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			return next.HandleFinalize(ctx, in)
+		})
+		// Each attempt is limited, so retries do not add to throttling
+		if err := stack.Finalize.Insert(limit, "Retry", middleware.After); err != nil {
+			return stack.Finalize.Add(limit, middleware.After)
+		}
+		return nil
+	}
+}
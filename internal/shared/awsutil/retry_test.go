@@ -0,0 +1,66 @@
+package awsutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(100)
+	ctx := context.Background()
+
+	// A burst of up to a second's worth of requests is not delayed
+	start := time.Now()
+	for range 100 {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+	// Later requests are spaced at the rate
+	start = time.Now()
+	for range 5 {
+		assert.NoError(t, l.Wait(ctx))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestRateLimiterContextDone(t *testing.T) {
+	l := newRateLimiter(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.NoError(t, l.Wait(ctx))
+
+	cancel()
+	assert.ErrorIs(t, l.Wait(ctx), context.Canceled)
+}
+
+func TestGetRateLimiterIsShared(t *testing.T) {
+	assert.Same(t, getRateLimiter("prod\x00eu-west-1\x00ECS"), getRateLimiter("prod\x00eu-west-1\x00ECS"))
+	assert.NotSame(t, getRateLimiter("prod\x00eu-west-1\x00ECS"), getRateLimiter("prod\x00us-east-1\x00ECS"))
+}
+
+func TestParseRetryMode(t *testing.T) {
+	assert.NoError(t, ParseRetryMode("standard"))
+	assert.NoError(t, ParseRetryMode("adaptive"))
+	assert.Error(t, ParseRetryMode("fast"))
+}
+
+func TestAddRateLimit(t *testing.T) {
+	rateLimit := RateLimit
+	t.Cleanup(func() { RateLimit = rateLimit })
+
+	RateLimit = 0
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	assert.NoError(t, addRateLimit("")(stack))
+	_, ok := stack.Finalize.Get("ascRateLimit")
+	assert.False(t, ok, "requests are not limited by default")
+
+	RateLimit = 5
+	assert.NoError(t, addRateLimit("")(stack))
+	_, ok = stack.Finalize.Get("ascRateLimit")
+	assert.True(t, ok)
+}
@@ -10,7 +10,8 @@ import (
 )
 
 type BaseService struct {
-	Config aws.Config
+	Config  aws.Config
+	Profile string
 }
 
 var Version = "dev"
@@ -19,6 +20,8 @@ func LoadDefaultConfig(ctx context.Context, profile string, region string) (*Bas
     opts := []func(*config.LoadOptions) error{
         config.WithAPIOptions([]func(*middleware.Stack) error{
             awsmiddleware.AddUserAgentKeyValue("asc", Version),
+            addCallLogging,
+            addRateLimit(profile),
         }),
     }
 
@@ -30,10 +33,15 @@ func LoadDefaultConfig(ctx context.Context, profile string, region string) (*Bas
         opts = append(opts, config.WithRegion(region))
     }
 
+    opts = append(opts, retryOptions()...)
+
     cfg, err := config.LoadDefaultConfig(ctx, opts...)
     if err != nil {
 		return nil, err
 	}
+	if AssumeRole.RoleARN != "" {
+		cfg.Credentials = assumeRoleCredentials(cfg, profile)
+	}
 
-	return &BaseService{Config: cfg}, nil
+	return &BaseService{Config: cfg, Profile: profile}, nil
 }
@@ -0,0 +1,271 @@
+package awsutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// CallLogLevel is how much is logged about each AWS call.
+type CallLogLevel int
+
+const (
+	CallLogOff   CallLogLevel = iota // Log nothing
+	CallLogDebug                     // Log a line for each call, from --debug
+	CallLogTrace                     // Also log the request and response bodies, from --trace
+)
+
+// CallLog is the level of logging of AWS calls, set by the global --debug and --trace flags.
+var CallLog CallLogLevel
+
+// redacted replaces sensitive values in logged bodies.
+const redacted = "[REDACTED]"
+
+// sensitiveFields are the fields whose values are never logged, such as credentials. Fields
+// are matched case-insensitively, as JSON APIs such as ECS use camelCase.
+var sensitiveFields = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"Password",
+	"RepositoryCredentials",
+	"SecretAccessKey",
+	"SecretString",
+	"SessionToken",
+	"TokenCode",
+	"UserData",
+}
+
+var (
+	callLogMu  sync.Mutex
+	callCounts = map[string]int{}
+	// callLogOutput is where calls are logged, replaced in tests.
+	callLogOutput io.Writer = os.Stderr
+)
+
+// addCallLogging adds middleware to a client's stack that logs each call to stderr, with its
+// service, operation, region, latency, attempts and request ID, and counts the calls for
+// PrintCallSummary. At CallLogTrace, the request and response bodies of each attempt are also
+// logged, with sensitive values redacted.
+func addCallLogging(stack *middleware.Stack) error {
+	if CallLog == CallLogOff {
+		return nil
+	}
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("ascCallLog", logCall), middleware.After); err != nil {
+		return err
+	}
+	if CallLog < CallLogTrace {
+		return nil
+	}
+	return stack.Deserialize.Add(middleware.DeserializeMiddlewareFunc("ascCallTrace", traceCall), middleware.After)
+}
+
+// logCall logs a call once it has completed, including any retries.
+func logCall(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+	latency := time.Since(start)
+
+	name := callName(ctx)
+	line := fmt.Sprintf("[debug] %s region=%s latency=%s", name, awsmiddleware.GetRegion(ctx), latency.Round(time.Millisecond))
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		line += fmt.Sprintf(" attempts=%d", len(results.Results))
+	}
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok && requestID != "" {
+		line += " request-id=" + requestID
+	}
+	if err != nil {
+		line += " error=" + callError(err)
+	}
+
+	callLogMu.Lock()
+	defer callLogMu.Unlock()
+	callCounts[name]++
+	fmt.Fprintln(callLogOutput, line)
+	return out, metadata, err
+}
+
+// traceCall logs the request and response bodies of each attempt of a call.
+func traceCall(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+	name := callName(ctx)
+	if req, ok := in.Request.(*smithyhttp.Request); ok && req.GetStream() != nil {
+		body, err := io.ReadAll(req.GetStream())
+		if err != nil {
+			return middleware.DeserializeOutput{}, middleware.Metadata{}, err
+		}
+		if in.Request, err = req.SetStream(bytes.NewReader(body)); err != nil {
+			return middleware.DeserializeOutput{}, middleware.Metadata{}, err
+		}
+		traceBody(name, "request", body)
+	}
+
+	out, metadata, err := next.HandleDeserialize(ctx, in)
+	// A request that failed to send has no response to log
+	if resp, ok := out.RawResponse.(*smithyhttp.Response); ok && resp.StatusCode != 0 && resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr == nil {
+			traceBody(name, fmt.Sprintf("response %d", resp.StatusCode), body)
+		}
+	}
+	return out, metadata, err
+}
+
+// traceBody logs a redacted request or response body.
+func traceBody(name, kind string, body []byte) {
+	callLogMu.Lock()
+	defer callLogMu.Unlock()
+	fmt.Fprintf(callLogOutput, "[trace] %s %s: %s\n", name, kind, RedactBody(body))
+}
+
+// callName returns the service and operation of a call, such as "EC2.DescribeInstances".
+func callName(ctx context.Context) string {
+	return awsmiddleware.GetServiceID(ctx) + "." + awsmiddleware.GetOperationName(ctx)
+}
+
+// callError returns the error code of a failed call, or the error if it has none.
+func callError(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return fmt.Sprintf("%q", err.Error())
+}
+
+// RedactBody returns a request or response body with sensitive values replaced. JSON, XML and
+// form-encoded bodies are supported. SecureString parameter values are redacted as well as the
+// values of sensitiveFields.
+func RedactBody(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	switch {
+	case len(trimmed) == 0:
+		return ""
+	case trimmed[0] == '{' || trimmed[0] == '[':
+		var v any
+		if err := json.Unmarshal(trimmed, &v); err == nil {
+			if out, err := json.Marshal(redactJSON(v)); err == nil {
+				return string(out)
+			}
+		}
+	case trimmed[0] == '<':
+		return redactXML(string(trimmed))
+	default:
+		if values, err := url.ParseQuery(string(trimmed)); err == nil {
+			return redactForm(values)
+		}
+	}
+	return redacted
+}
+
+// isSensitive reports whether the values of a field are never logged.
+func isSensitive(key string) bool {
+	return slices.ContainsFunc(sensitiveFields, func(field string) bool {
+		return strings.EqualFold(field, key)
+	})
+}
+
+// redactJSON replaces sensitive values in a decoded JSON value. The values of ECS container
+// environment variables, given as {"name": ..., "value": ...} in "environment", are redacted
+// as well as the values of sensitiveFields.
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSensitive(key) || (key == "Value" && v["Type"] == "SecureString") {
+				v[key] = redacted
+				continue
+			}
+			if strings.EqualFold(key, "environment") {
+				redactEnvironment(value)
+			}
+			v[key] = redactJSON(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
+
+// redactEnvironment replaces the values of a list of ECS environment variables.
+func redactEnvironment(v any) {
+	variables, ok := v.([]any)
+	if !ok {
+		return
+	}
+	for _, variable := range variables {
+		variable, ok := variable.(map[string]any)
+		if !ok {
+			continue
+		}
+		for key := range variable {
+			if strings.EqualFold(key, "value") {
+				variable[key] = redacted
+			}
+		}
+	}
+}
+
+// xmlFieldPattern matches the elements of sensitiveFields in an XML body.
+var xmlFieldPattern = regexp.MustCompile(`(?is)<(` + strings.Join(sensitiveFields, "|") + `)>.*?</(?:` + strings.Join(sensitiveFields, "|") + `)>`)
+
+// redactXML replaces the contents of sensitive elements in an XML body.
+func redactXML(body string) string {
+	return xmlFieldPattern.ReplaceAllStringFunc(body, func(element string) string {
+		name := element[1:strings.Index(element, ">")]
+		return "<" + name + ">" + redacted + "</" + name + ">"
+	})
+}
+
+// redactForm replaces sensitive values in a form-encoded body, such as an EC2 or RDS request,
+// and returns it decoded for readability. Keys are matched on their last part, so that list
+// members such as "Filter.1.Password" are covered.
+func redactForm(values url.Values) string {
+	var pairs []string
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		parts := strings.Split(key, ".")
+		for _, value := range values[key] {
+			if isSensitive(parts[len(parts)-1]) {
+				value = redacted
+			}
+			pairs = append(pairs, key+"="+value)
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// PrintCallSummary writes the number of calls made to each operation, if calls are logged.
+func PrintCallSummary() {
+	if CallLog == CallLogOff {
+		return
+	}
+	callLogMu.Lock()
+	defer callLogMu.Unlock()
+
+	total := 0
+	for _, count := range callCounts {
+		total += count
+	}
+	fmt.Fprintf(callLogOutput, "[debug] %d AWS calls\n", total)
+	for _, name := range slices.Sorted(maps.Keys(callCounts)) {
+		fmt.Fprintf(callLogOutput, "[debug]   %-50s %d\n", name, callCounts[name])
+	}
+}
@@ -0,0 +1,92 @@
+package awsutil
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "SecureString parameter",
+			body: `{"Parameter":{"Name":"/app/db","Type":"SecureString","Value":"s3cret"}}`,
+			want: `{"Parameter":{"Name":"/app/db","Type":"SecureString","Value":"[REDACTED]"}}`,
+		},
+		{
+			name: "String parameter",
+			body: `{"Name":"/app/url","Type":"String","Value":"https://example.com"}`,
+			want: `{"Name":"/app/url","Type":"String","Value":"https://example.com"}`,
+		},
+		{
+			name: "XML credentials",
+			body: `<Credentials><AccessKeyId>ASIA1</AccessKeyId><SecretAccessKey>abc</SecretAccessKey><SessionToken>xyz</SessionToken></Credentials>`,
+			want: `<Credentials><AccessKeyId>ASIA1</AccessKeyId><SecretAccessKey>[REDACTED]</SecretAccessKey><SessionToken>[REDACTED]</SessionToken></Credentials>`,
+		},
+		{
+			name: "form request",
+			body: "Action=ModifyDBInstance&DBInstanceIdentifier=db-1&MasterUserPassword=hunter2",
+			want: "Action=ModifyDBInstance&DBInstanceIdentifier=db-1&MasterUserPassword=[REDACTED]",
+		},
+		{
+			name: "camelCase fields",
+			body: `{"dbInstanceIdentifier":"db-1","masterUserPassword":"hunter2","authtoken":"abc"}`,
+			want: `{"authtoken":"[REDACTED]","dbInstanceIdentifier":"db-1","masterUserPassword":"[REDACTED]"}`,
+		},
+		{
+			name: "ECS task definition",
+			body: `{"containerDefinitions":[{"name":"web","environment":[{"name":"DB_PASSWORD","value":"hunter2"}],"repositoryCredentials":{"credentialsParameter":"arn:aws:secretsmanager:eu-west-1:123456789012:secret:registry"}}]}`,
+			want: `{"containerDefinitions":[{"environment":[{"name":"DB_PASSWORD","value":"[REDACTED]"}],"name":"web","repositoryCredentials":"[REDACTED]"}]}`,
+		},
+		{
+			name: "ECS container overrides",
+			body: `{"overrides":{"containerOverrides":[{"name":"web","environment":[{"name":"API_KEY","value":"abc"},{"name":"DEBUG"}]}]}}`,
+			want: `{"overrides":{"containerOverrides":[{"environment":[{"name":"API_KEY","value":"[REDACTED]"},{"name":"DEBUG"}],"name":"web"}]}}`,
+		},
+		{
+			name: "XML element in another case",
+			body: `<result><password>abc</password></result>`,
+			want: `<result><password>[REDACTED]</password></result>`,
+		},
+		{
+			name: "form request in another case",
+			body: "Action=CreateUser&Member.1.password=hunter2",
+			want: "Action=CreateUser&Member.1.password=[REDACTED]",
+		},
+		{
+			name: "empty",
+			body: "",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RedactBody([]byte(tt.body)))
+		})
+	}
+}
+
+func TestPrintCallSummary(t *testing.T) {
+	var out bytes.Buffer
+	callLogOutput = &out
+	callCounts = map[string]int{"SSM.GetParameter": 1, "EC2.DescribeInstances": 2}
+	t.Cleanup(func() {
+		CallLog = CallLogOff
+		callCounts = map[string]int{}
+		callLogOutput = os.Stderr
+	})
+
+	PrintCallSummary()
+	assert.Empty(t, out.String())
+
+	CallLog = CallLogDebug
+	PrintCallSummary()
+	assert.Contains(t, out.String(), "[debug] 3 AWS calls\n")
+	assert.Regexp(t, `(?s)EC2\.DescribeInstances\s+2\n.*SSM\.GetParameter\s+1\n`, out.String())
+}
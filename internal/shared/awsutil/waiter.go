@@ -2,53 +2,162 @@ package awsutil
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
 	"time"
 )
 
+// ErrWaitTimeout is returned by WaitForStatus when MaxWait is exceeded.
+var ErrWaitTimeout = errors.New("timed out")
+
+// Default polling behaviour used by the wait commands.
+const (
+	DefaultPollInterval    = 5 * time.Second
+	DefaultMaxPollInterval = 30 * time.Second
+	DefaultBackoff         = 1.5
+	DefaultJitter          = 0.2
+)
+
 // WaitConfig configures the polling behaviour for WaitForStatus.
 type WaitConfig struct {
 	ResourceName string
-	PollInterval time.Duration
+	PollInterval time.Duration // Delay before the second poll
+	MaxInterval  time.Duration // Upper bound for the delay between polls. Defaults to PollInterval.
+	Backoff      float64       // Multiplier applied to the delay after each poll. Values <= 1 poll at a fixed interval.
+	Jitter       float64       // Fraction of the delay to randomise by, e.g. 0.2 for ±20%
 	MaxWait      time.Duration
 	StatusFunc   func(ctx context.Context) (string, error)
 	IsTerminal   func(status string) bool
+
+	// Output receives a line for each status change. Defaults to os.Stdout.
+	Output io.Writer
+	// Quiet disables writing to Output.
+	Quiet bool
+	// Events writes each status change to Output as a newline-delimited JSON WaitEvent
+	// instead of a line of text.
+	Events bool
+	// OnProgress is called with every polled status, whether or not it changed.
+	OnProgress func(event WaitEvent)
 }
 
-// WaitForStatus polls StatusFunc at PollInterval until IsTerminal returns true
-// or MaxWait is exceeded. Returns the final status.
-func WaitForStatus(ctx context.Context, config WaitConfig) (string, error) {
-	start := time.Now()
-	ticker := time.NewTicker(config.PollInterval)
-	defer ticker.Stop()
+// WaitEvent describes a polled status of a resource.
+type WaitEvent struct {
+	Resource string
+	Status   string
+	Previous string // Status from the previous poll, empty on the first poll
+	Elapsed  time.Duration
+	Time     time.Time
+	Terminal bool
+}
+
+// Changed returns true if the status differs from the previous poll.
+func (e WaitEvent) Changed() bool {
+	return e.Status != e.Previous
+}
+
+// MarshalJSON encodes the event with the elapsed time in seconds.
+func (e WaitEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Time     time.Time `json:"time"`
+		Resource string    `json:"resource"`
+		Status   string    `json:"status"`
+		Previous string    `json:"previous,omitempty"`
+		Elapsed  float64   `json:"elapsed_seconds"`
+		Terminal bool      `json:"terminal"`
+	}{e.Time, e.Resource, e.Status, e.Previous, e.Elapsed.Seconds(), e.Terminal})
+}
+
+// report passes an event to OnProgress and writes it to Output if the status changed.
+func (c WaitConfig) report(event WaitEvent) error {
+	if c.OnProgress != nil {
+		c.OnProgress(event)
+	}
+	if c.Quiet || !event.Changed() {
+		return nil
+	}
+
+	w := c.Output
+	if w == nil {
+		w = os.Stdout
+	}
+	if c.Events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	}
+	_, err := fmt.Fprintf(w, "Status: %s (%s elapsed)\n", event.Status, event.Elapsed)
+	return err
+}
 
-	// Check immediately before first tick
-	status, err := config.StatusFunc(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get status: %w", err)
+// nextInterval returns the delay to use after the given one, applying Backoff up to MaxInterval.
+func (c WaitConfig) nextInterval(interval time.Duration) time.Duration {
+	if c.Backoff <= 1 {
+		return interval
+	}
+	next := time.Duration(float64(interval) * c.Backoff)
+	if limit := max(c.MaxInterval, c.PollInterval); next > limit {
+		return limit
 	}
-	fmt.Printf("Status: %s (0s elapsed)\n", status)
-	if config.IsTerminal(status) {
-		return status, nil
+	return next
+}
+
+// jitter randomises the delay by up to ±Jitter of its length.
+func (c WaitConfig) jitter(interval time.Duration) time.Duration {
+	if c.Jitter <= 0 {
+		return interval
 	}
+	spread := float64(interval) * c.Jitter
+	return time.Duration(float64(interval) + spread*(2*rand.Float64()-1))
+}
+
+// WaitForStatus polls StatusFunc until IsTerminal returns true or MaxWait is exceeded,
+// and returns the final status. The delay between polls starts at PollInterval and grows
+// by Backoff up to MaxInterval. Only changes of status are written to Output.
+func WaitForStatus(ctx context.Context, config WaitConfig) (string, error) {
+	start := time.Now()
+	interval := config.PollInterval
+	previous := ""
 
 	for {
+		status, err := config.StatusFunc(ctx)
+		if err != nil {
+			return "", fmt.Errorf("get status: %w", err)
+		}
+		terminal := config.IsTerminal(status)
+		event := WaitEvent{
+			Resource: config.ResourceName,
+			Status:   status,
+			Previous: previous,
+			Elapsed:  time.Since(start).Truncate(time.Second),
+			Time:     time.Now(),
+			Terminal: terminal,
+		}
+		if err := config.report(event); err != nil {
+			return "", fmt.Errorf("write status: %w", err)
+		}
+		if terminal {
+			return status, nil
+		}
+		previous = status
+
+		remaining := config.MaxWait - time.Since(start)
+		if remaining <= 0 {
+			return "", fmt.Errorf("%w after %s waiting for %s", ErrWaitTimeout, config.MaxWait, config.ResourceName)
+		}
+		timer := time.NewTimer(min(config.jitter(interval), remaining))
 		select {
 		case <-ctx.Done():
+			timer.Stop()
 			return "", ctx.Err()
-		case <-ticker.C:
-			elapsed := time.Since(start).Truncate(time.Second)
-			if elapsed > config.MaxWait {
-				return "", fmt.Errorf("timeout after %s waiting for %s", config.MaxWait, config.ResourceName)
-			}
-			status, err := config.StatusFunc(ctx)
-			if err != nil {
-				return "", fmt.Errorf("get status: %w", err)
-			}
-			fmt.Printf("Status: %s (%s elapsed)\n", status, elapsed)
-			if config.IsTerminal(status) {
-				return status, nil
-			}
+		case <-timer.C:
 		}
+		interval = config.nextInterval(interval)
 	}
 }
@@ -0,0 +1,110 @@
+package awsutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// statusSequence returns a StatusFunc that returns each status in turn, repeating the last.
+func statusSequence(statuses ...string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		return status, nil
+	}
+}
+
+func testWaitConfig(out *bytes.Buffer, statuses ...string) WaitConfig {
+	return WaitConfig{
+		ResourceName: "i-123",
+		PollInterval: time.Millisecond,
+		MaxWait:      time.Second,
+		StatusFunc:   statusSequence(statuses...),
+		IsTerminal:   func(status string) bool { return status == "running" },
+		Output:       out,
+	}
+}
+
+// Unit test for WaitForStatus
+func TestWaitForStatus(t *testing.T) {
+	var out bytes.Buffer
+	var polled []string
+	config := testWaitConfig(&out, "pending", "pending", "pending", "running")
+	config.OnProgress = func(event WaitEvent) { polled = append(polled, event.Status) }
+
+	status, err := WaitForStatus(context.Background(), config)
+	assert.NoError(t, err)
+	assert.Equal(t, "running", status)
+	assert.Equal(t, []string{"pending", "pending", "pending", "running"}, polled)
+	assert.Equal(t, 2, strings.Count(out.String(), "\n"), "only status changes are written")
+	assert.Contains(t, out.String(), "Status: pending")
+	assert.Contains(t, out.String(), "Status: running")
+}
+
+func TestWaitForStatusEvents(t *testing.T) {
+	var out bytes.Buffer
+	config := testWaitConfig(&out, "pending", "running")
+	config.Events = true
+
+	_, err := WaitForStatus(context.Background(), config)
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 2)
+	var event map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+	assert.Equal(t, "i-123", event["resource"])
+	assert.Equal(t, "running", event["status"])
+	assert.Equal(t, "pending", event["previous"])
+	assert.Equal(t, true, event["terminal"])
+}
+
+func TestWaitForStatusQuiet(t *testing.T) {
+	var out bytes.Buffer
+	config := testWaitConfig(&out, "pending", "running")
+	config.Quiet = true
+
+	_, err := WaitForStatus(context.Background(), config)
+	assert.NoError(t, err)
+	assert.Empty(t, out.String())
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	var out bytes.Buffer
+	config := testWaitConfig(&out, "pending")
+	config.MaxWait = 10 * time.Millisecond
+
+	_, err := WaitForStatus(context.Background(), config)
+	assert.ErrorIs(t, err, ErrWaitTimeout)
+}
+
+func TestNextInterval(t *testing.T) {
+	config := WaitConfig{PollInterval: time.Second, MaxInterval: 4 * time.Second, Backoff: 2}
+	interval := config.PollInterval
+	var intervals []time.Duration
+	for range 4 {
+		interval = config.nextInterval(interval)
+		intervals = append(intervals, interval)
+	}
+	assert.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 4 * time.Second, 4 * time.Second}, intervals)
+
+	config.Backoff = 0
+	assert.Equal(t, time.Second, config.nextInterval(time.Second))
+}
+
+func TestJitter(t *testing.T) {
+	config := WaitConfig{Jitter: 0.2}
+	for range 100 {
+		d := config.jitter(10 * time.Second)
+		assert.GreaterOrEqual(t, d, 8*time.Second)
+		assert.LessOrEqual(t, d, 12*time.Second)
+	}
+}
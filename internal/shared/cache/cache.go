@@ -0,0 +1,100 @@
+// Package cache stores JSON values on disk for a limited time, so that repeated invocations of
+// asc, such as shell completions, can reuse results rather than calling AWS again.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/config"
+)
+
+// Cache is a directory of cached values, each stored in a file named after the hash of its key.
+type Cache struct {
+	Dir string
+}
+
+// New returns the cache with the given name, in the asc cache directory.
+func New(name string) (*Cache, error) {
+	dir, err := config.CacheDir()
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{Dir: filepath.Join(dir, name)}, nil
+}
+
+// path returns the file a key is stored in.
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get reads the value stored for key into v. It returns false if there is no value, the value is
+// older than ttl, or it cannot be read.
+func (c *Cache) Get(key string, ttl time.Duration, v any) bool {
+	path := c.path(key)
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > ttl {
+		return false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// Put stores v for key.
+func (c *Cache) Put(key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// Cached values name accounts and resources, so they are only readable by the user
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return fmt.Errorf("create cache directory: %w", err)
+	}
+	// Write to a temporary file and rename it, so concurrent readers never see a partial value
+	tmp, err := os.CreateTemp(c.Dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("write cache: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write cache: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write cache: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the value stored for key.
+func (c *Cache) Delete(key string) error {
+	err := os.Remove(c.path(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete cache entry: %w", err)
+	}
+	return nil
+}
+
+// Clear removes every value in the cache.
+func (c *Cache) Clear() error {
+	if err := os.RemoveAll(c.Dir); err != nil {
+		return fmt.Errorf("clear cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutAndGet(t *testing.T) {
+	c := &Cache{Dir: t.TempDir()}
+
+	var values []string
+	assert.False(t, c.Get("ec2/instances", time.Minute, &values))
+
+	require.NoError(t, c.Put("ec2/instances", []string{"i-0abc", "i-0def"}))
+	require.True(t, c.Get("ec2/instances", time.Minute, &values))
+	assert.Equal(t, []string{"i-0abc", "i-0def"}, values)
+
+	var other []string
+	assert.False(t, c.Get("rds/instances", time.Minute, &other))
+}
+
+func TestGetExpired(t *testing.T) {
+	c := &Cache{Dir: t.TempDir()}
+	require.NoError(t, c.Put("key", "value"))
+
+	old := time.Now().Add(-2 * time.Minute)
+	require.NoError(t, os.Chtimes(c.path("key"), old, old))
+
+	var value string
+	assert.False(t, c.Get("key", time.Minute, &value))
+	assert.True(t, c.Get("key", time.Hour, &value))
+	assert.Equal(t, "value", value)
+}
+
+func TestDeleteAndClear(t *testing.T) {
+	c := &Cache{Dir: t.TempDir()}
+	require.NoError(t, c.Put("a", 1))
+	require.NoError(t, c.Put("b", 2))
+
+	var value int
+	require.NoError(t, c.Delete("a"))
+	require.NoError(t, c.Delete("missing"))
+	assert.False(t, c.Get("a", time.Minute, &value))
+	assert.True(t, c.Get("b", time.Minute, &value))
+
+	require.NoError(t, c.Clear())
+	assert.False(t, c.Get("b", time.Minute, &value))
+}
@@ -0,0 +1,55 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+)
+
+// AddAssumeRoleFlags adds the global --role-arn, --external-id, --mfa-serial and --duration
+// flags to the root command.
+func AddAssumeRoleFlags(root *cobra.Command) {
+	root.PersistentFlags().String("role-arn", "", "ARN of a role to assume with the profile's credentials")
+	root.PersistentFlags().String("external-id", "", "External ID to pass when assuming the role given by --role-arn")
+	root.PersistentFlags().String("mfa-serial", "", "ARN of the MFA device to prompt for a token code when assuming the role")
+	root.PersistentFlags().Duration("duration", time.Hour, "Duration of the role session, from 15m to 12h")
+}
+
+// ApplyAssumeRoleFlags sets the role that AWS services are called with, from --role-arn and the
+// related flags.
+func ApplyAssumeRoleFlags(cmd *cobra.Command) error {
+	opts := resolvedAssumeRole(cmd)
+	if opts.RoleARN == "" {
+		for _, name := range []string{"external-id", "mfa-serial", "duration"} {
+			if cmd.Root().PersistentFlags().Changed(name) {
+				return fmt.Errorf("--%s requires --role-arn", name)
+			}
+		}
+	}
+	if opts.Duration < awsutil.AssumeRoleMinDuration || opts.Duration > awsutil.AssumeRoleMaxDuration {
+		return fmt.Errorf("invalid value for duration flag: %s. Must be from %s to %s",
+			opts.Duration, awsutil.AssumeRoleMinDuration, awsutil.AssumeRoleMaxDuration)
+	}
+	awsutil.AssumeRole = opts
+	return nil
+}
+
+// resolvedAssumeRole returns the role given by the root command's flags. They are read from the
+// root, since a command may have a flag of its own with the same name, such as asg modify's
+// --duration.
+func resolvedAssumeRole(cmd *cobra.Command) awsutil.AssumeRoleOptions {
+	flags := cmd.Root().PersistentFlags()
+	var opts awsutil.AssumeRoleOptions
+	opts.RoleARN, _ = flags.GetString("role-arn")
+	opts.ExternalID, _ = flags.GetString("external-id")
+	opts.MFASerial, _ = flags.GetString("mfa-serial")
+	opts.Duration, _ = flags.GetDuration("duration")
+	return opts
+}
+
+// errNoMFAPrompt is returned when a role session needs an MFA token code during shell
+// completion, where there is no terminal to prompt in.
+var errNoMFAPrompt = errors.New("an MFA token code is needed to assume the role. Run a command with --mfa-serial first")
@@ -0,0 +1,57 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+)
+
+// bypassCacheAnnotation marks commands that always read from AWS rather than the response cache.
+const bypassCacheAnnotation = "asc/bypass-cache"
+
+// AddCacheFlags adds the global --cache-ttl and --no-cache flags to the root command.
+func AddCacheFlags(root *cobra.Command) {
+	root.PersistentFlags().Duration("cache-ttl", 0, "Cache the responses of read-only AWS calls on disk for this long, e.g. 5m (0 to disable)")
+	root.PersistentFlags().Bool("no-cache", false, "Don't read responses from the cache, even if --cache-ttl is set")
+}
+
+// BypassCache marks a command as always reading from AWS. Commands that poll, such as wait, and
+// commands that change resources, which must see their current state, bypass the cache. Commands
+// that support --dry-run are treated as changing resources without being marked.
+func BypassCache(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[bypassCacheAnnotation] = "true"
+}
+
+// ApplyCacheFlags sets how long the command reuses cached responses, from --cache-ttl. The cache
+// is not read with --no-cache, with --watch, or by commands that bypass it. Calls that change
+// resources clear the cache either way.
+func ApplyCacheFlags(cmd *cobra.Command) error {
+	ttl, _ := cmd.Flags().GetDuration("cache-ttl")
+	if ttl < 0 {
+		return fmt.Errorf("invalid value for cache-ttl flag: %s. Must be 0 or greater", ttl)
+	}
+	noCache, _ := cmd.Flags().GetBool("no-cache")
+	if noCache || bypassesCache(cmd) {
+		ttl = 0
+	}
+	awsutil.CacheTTL = ttl
+	return nil
+}
+
+// bypassesCache returns true if the command must read from AWS rather than the cache.
+func bypassesCache(cmd *cobra.Command) bool {
+	if cmd.Annotations[bypassCacheAnnotation] == "true" || cmd.Annotations[dryRunAnnotation] == "true" {
+		return true
+	}
+	if cmd.LocalNonPersistentFlags().Lookup("dry-run") != nil {
+		return true
+	}
+	if watch := cmd.Flags().Lookup("watch"); watch != nil && watch.Changed {
+		return true
+	}
+	return false
+}
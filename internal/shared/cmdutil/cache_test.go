@@ -0,0 +1,54 @@
+package cmdutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCacheTestCmd returns a command under a root with the cache flags, parsed from args.
+func newCacheTestCmd(t *testing.T, cmd *cobra.Command, args ...string) *cobra.Command {
+	t.Cleanup(func() { awsutil.CacheTTL = 0 })
+	root := &cobra.Command{Use: "asc"}
+	AddCacheFlags(root)
+	root.AddCommand(cmd)
+	assert.NoError(t, cmd.ParseFlags(args))
+	return cmd
+}
+
+func TestApplyCacheFlags(t *testing.T) {
+	cmd := newCacheTestCmd(t, &cobra.Command{Use: "ls"}, "--cache-ttl", "5m")
+	assert.NoError(t, ApplyCacheFlags(cmd))
+	assert.Equal(t, 5*time.Minute, awsutil.CacheTTL)
+
+	cmd = newCacheTestCmd(t, &cobra.Command{Use: "ls"}, "--cache-ttl", "5m", "--no-cache")
+	assert.NoError(t, ApplyCacheFlags(cmd))
+	assert.Zero(t, awsutil.CacheTTL)
+
+	cmd = newCacheTestCmd(t, &cobra.Command{Use: "ls"}, "--cache-ttl", "-1m")
+	assert.Error(t, ApplyCacheFlags(cmd))
+}
+
+func TestApplyCacheFlagsBypass(t *testing.T) {
+	wait := &cobra.Command{Use: "wait"}
+	BypassCache(wait)
+	stop := &cobra.Command{Use: "stop"}
+	AllowDryRun(stop)
+	set := &cobra.Command{Use: "set"}
+	set.Flags().Bool("dry-run", false, "")
+	ls := &cobra.Command{Use: "ls"}
+	ls.Flags().String("watch", "", "")
+
+	for _, cmd := range []*cobra.Command{wait, stop, set} {
+		cmd = newCacheTestCmd(t, cmd, "--cache-ttl", "5m")
+		assert.NoError(t, ApplyCacheFlags(cmd))
+		assert.Zero(t, awsutil.CacheTTL, cmd.Name())
+	}
+
+	cmd := newCacheTestCmd(t, ls, "--cache-ttl", "5m", "--watch", "10s")
+	assert.NoError(t, ApplyCacheFlags(cmd))
+	assert.Zero(t, awsutil.CacheTTL)
+}
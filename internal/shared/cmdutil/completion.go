@@ -0,0 +1,149 @@
+package cmdutil
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/cache"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// completionTTL is how long completion results are reused before AWS is called again.
+	completionTTL = time.Minute
+	// completionTimeout bounds the AWS calls made for a completion, so the shell never hangs.
+	completionTimeout = 5 * time.Second
+)
+
+// CompletionFunc returns the completions for a profile and region, as "value" or
+// "value\tdescription". toComplete is the text being completed, for functions that list
+// resources by prefix.
+type CompletionFunc func(ctx context.Context, profile, region, toComplete string) ([]string, error)
+
+// CompleteResources returns a ValidArgsFunction that completes the first maxArgs arguments
+// (0 for all) with live resources returned by fetch, such as instance IDs. Results are cached
+// on disk for a minute under kind, the profile and the region, so repeated tab presses do not
+// call AWS. Arguments already given are not offered again.
+func CompleteResources(kind string, maxArgs int, fetch CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if maxArgs > 0 && len(args) >= maxArgs {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		completions := cachedCompletions(cmd, kind, "", fetch)
+		return filterCompletions(completions, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// CompleteFlagResources returns a flag completion function that completes the flag value with
+// live resources returned by fetch, cached as for CompleteResources.
+func CompleteFlagResources(kind string, fetch CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		completions := cachedCompletions(cmd, kind, "", fetch)
+		return filterCompletions(completions, nil, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// CompleteFlagList returns a completion function for a comma-separated list flag, such as
+// --tags, that completes the last value of the list with live values returned by fetch, cached as
+// for CompleteResources. Values already in the list are not offered again.
+func CompleteFlagList(kind string, fetch CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeList(cachedCompletions(cmd, kind, "", fetch), toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// completeList returns the completions of the last value of the comma-separated list toComplete,
+// each prefixed with the values before it.
+func completeList(completions []string, toComplete string) []string {
+	i := strings.LastIndex(toComplete, ",") + 1
+	prefix, last := toComplete[:i], toComplete[i:]
+	var given []string
+	if prefix != "" {
+		given = strings.Split(strings.TrimSuffix(prefix, ","), ",")
+	}
+	filtered := filterCompletions(completions, given, last)
+	for j, completion := range filtered {
+		filtered[j] = prefix + completion
+	}
+	return filtered
+}
+
+// CompletePaths returns a ValidArgsFunction that completes the first maxArgs arguments (0 for
+// all) one path segment at a time, such as SSM parameter names. fetch is called with the
+// parent path of the text being completed, up to and including its last "/", and returns the
+// names and sub-paths directly under it. Sub-paths end in "/" so that completion can continue
+// into them. Results are cached for each parent path.
+func CompletePaths(kind string, maxArgs int, fetch CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if maxArgs > 0 && len(args) >= maxArgs {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		parent := toComplete[:strings.LastIndex(toComplete, "/")+1]
+		completions := filterCompletions(cachedCompletions(cmd, kind, parent, fetch), nil, toComplete)
+
+		directive := cobra.ShellCompDirectiveNoFileComp
+		for _, completion := range completions {
+			if strings.HasSuffix(completion, "/") {
+				directive |= cobra.ShellCompDirectiveNoSpace
+				break
+			}
+		}
+		return completions, directive
+	}
+}
+
+// cachedCompletions returns the completions of kind for the command's profile and region, from
+// the completion cache if they are recent enough. Errors, such as missing credentials, return no
+// completions, since there is nowhere to report them during completion.
+func cachedCompletions(cmd *cobra.Command, kind, toComplete string, fetch CompletionFunc) []string {
+	profile, region := resolvedProfileRegion(cmd)
+	// Completion runs without the root command's pre-run, so the endpoint and role are resolved
+	// here. A role session is only used if it is cached, since there is no terminal for MFA.
+	awsutil.EndpointURL = resolvedEndpointURL(cmd)
+	awsutil.AssumeRole = resolvedAssumeRole(cmd)
+	awsutil.PromptMFAToken = func(string) (string, error) { return "", errNoMFAPrompt }
+	key := strings.Join([]string{kind, profile, region, awsutil.EndpointURL, awsutil.AssumeRole.RoleARN, toComplete}, "\x00")
+
+	c, err := cache.New("completion")
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil
+	}
+	var completions []string
+	if c.Get(key, completionTTL, &completions) {
+		return completions
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+
+	completions, err = fetch(ctx, profile, region, toComplete)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil
+	}
+	if err := c.Put(key, completions); err != nil {
+		cobra.CompDebugln(err.Error(), false)
+	}
+	return completions
+}
+
+// filterCompletions returns the completions that start with toComplete, leaving out values that
+// are already in args.
+func filterCompletions(completions, args []string, toComplete string) []string {
+	var filtered []string
+	for _, completion := range completions {
+		value, _, _ := strings.Cut(completion, "\t")
+		if strings.HasPrefix(value, toComplete) && !slices.Contains(args, value) {
+			filtered = append(filtered, completion)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,97 @@
+package cmdutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCompletionTestCmd returns a command with the root persistent flags that completion reads.
+func newCompletionTestCmd() *cobra.Command {
+	root := &cobra.Command{Use: "asc"}
+	root.PersistentFlags().String("profile", "", "")
+	root.PersistentFlags().String("region", "", "")
+	cmd := &cobra.Command{Use: "stop"}
+	root.AddCommand(cmd)
+	return cmd
+}
+
+func TestCompleteResources(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	calls := 0
+	complete := CompleteResources("ec2/instance", 0, func(ctx context.Context, profile, region, _ string) ([]string, error) {
+		calls++
+		return []string{"i-0abc\tweb (running)", "i-0abd\tapi (stopped)", "i-0def\tdb (running)"}, nil
+	})
+	cmd := newCompletionTestCmd()
+
+	completions, directive := complete(cmd, nil, "i-0ab")
+	assert.Equal(t, []string{"i-0abc\tweb (running)", "i-0abd\tapi (stopped)"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	// Arguments already given are left out, and the cached results are reused
+	completions, _ = complete(cmd, []string{"i-0abc"}, "")
+	assert.Equal(t, []string{"i-0abd\tapi (stopped)", "i-0def\tdb (running)"}, completions)
+	assert.Equal(t, 1, calls)
+
+	// A different region is cached separately
+	assert.NoError(t, cmd.Root().PersistentFlags().Set("region", "eu-west-1"))
+	complete(cmd, nil, "")
+	assert.Equal(t, 2, calls)
+}
+
+func TestCompleteResourcesMaxArgs(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	complete := CompleteResources("rds/instance", 1, func(ctx context.Context, profile, region, _ string) ([]string, error) {
+		return []string{"db-1", "db-2"}, nil
+	})
+
+	completions, _ := complete(newCompletionTestCmd(), []string{"db-1"}, "")
+	assert.Empty(t, completions)
+}
+
+func TestCompletePaths(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	var parents []string
+	complete := CompletePaths("ssm/parameter", 1, func(ctx context.Context, profile, region, parent string) ([]string, error) {
+		parents = append(parents, parent)
+		switch parent {
+		case "/app/":
+			return []string{"/app/key", "/app/prod/"}, nil
+		case "/app/prod/":
+			return []string{"/app/prod/db", "/app/prod/url"}, nil
+		}
+		return nil, nil
+	})
+	cmd := newCompletionTestCmd()
+
+	completions, directive := complete(cmd, nil, "/app/p")
+	assert.Equal(t, []string{"/app/prod/"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveNoSpace, directive)
+
+	completions, directive = complete(cmd, nil, "/app/prod/")
+	assert.Equal(t, []string{"/app/prod/db", "/app/prod/url"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	assert.Equal(t, []string{"/app/", "/app/prod/"}, parents)
+}
+
+func TestCompleteFlagList(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	complete := CompleteFlagList("ec2/instance/tag-keys", func(ctx context.Context, profile, region, _ string) ([]string, error) {
+		return []string{"Env", "Name", "Owner"}, nil
+	})
+	cmd := newCompletionTestCmd()
+
+	completions, directive := complete(cmd, nil, "")
+	assert.Equal(t, []string{"Env", "Name", "Owner"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveNoSpace, directive)
+
+	// The last value of the list is completed, and values already given are left out
+	completions, _ = complete(cmd, nil, "Name,")
+	assert.Equal(t, []string{"Name,Env", "Name,Owner"}, completions)
+	completions, _ = complete(cmd, nil, "Name,O")
+	assert.Equal(t, []string{"Name,Owner"}, completions)
+}
@@ -0,0 +1,27 @@
+package cmdutil
+
+import (
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+)
+
+// AddDebugFlags adds the global --debug and --trace flags to the root command.
+func AddDebugFlags(root *cobra.Command) {
+	root.PersistentFlags().Bool("debug", false, "Log each AWS call to stderr, and a count of the calls at exit")
+	root.PersistentFlags().Bool("trace", false, "Log each AWS call with its request and response bodies, with secrets redacted")
+}
+
+// ApplyDebugFlags sets how much is logged about each AWS call, from --debug and --trace.
+func ApplyDebugFlags(cmd *cobra.Command) {
+	flags := cmd.Root().PersistentFlags()
+	debug, _ := flags.GetBool("debug")
+	trace, _ := flags.GetBool("trace")
+	switch {
+	case trace:
+		awsutil.CallLog = awsutil.CallLogTrace
+	case debug:
+		awsutil.CallLog = awsutil.CallLogDebug
+	default:
+		awsutil.CallLog = awsutil.CallLogOff
+	}
+}
@@ -0,0 +1,79 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// dryRunAnnotation marks commands that honour the global --dry-run flag.
+const dryRunAnnotation = "asc/dry-run"
+
+// AddDryRunFlag adds the global --dry-run flag to the root command. It has no shorthand, as
+// some commands define their own --dry-run/-n flag, which takes precedence.
+func AddDryRunFlag(root *cobra.Command) {
+	root.PersistentFlags().Bool("dry-run", false, "Show the changes a command would make, without making them")
+}
+
+// AllowDryRun marks a command as honouring the global --dry-run flag.
+func AllowDryRun(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[dryRunAnnotation] = "true"
+}
+
+// IsDryRun returns true if --dry-run is set for the command.
+func IsDryRun(cmd *cobra.Command) bool {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	return dryRun
+}
+
+// CheckDryRun returns an error if --dry-run is set for a command that does not honour it, so
+// that the command does not make changes the user did not expect.
+func CheckDryRun(cmd *cobra.Command) error {
+	if !IsDryRun(cmd) || cmd.Annotations[dryRunAnnotation] == "true" {
+		return nil
+	}
+	if cmd.LocalNonPersistentFlags().Lookup("dry-run") != nil {
+		return nil
+	}
+	return fmt.Errorf("--dry-run is not supported by %s", cmd.CommandPath())
+}
+
+// Change is a single change to a resource shown in a dry-run plan. An empty Before means the
+// value does not exist yet, and an empty After that it will be removed.
+type Change struct {
+	Resource string
+	Field    string
+	Before   string
+	After    string
+}
+
+// RenderPlan renders the changes a command would make as a before/after table.
+func RenderPlan(changes []Change) {
+	RenderChanges("Dry run: no changes were made", changes)
+}
+
+// RenderChanges renders changes as a before/after table with the given title.
+func RenderChanges(title string, changes []Change) {
+	table := tablewriter.NewAscWriter(tablewriter.AscTableRenderOptions{
+		Title: title,
+	})
+	table.AppendHeader([]string{"Resource", "Field", "Before", "After"})
+	for _, change := range changes {
+		table.AppendRow(tablewriter.Row{Values: []string{
+			change.Resource, change.Field, planValue(change.Before), planValue(change.After),
+		}})
+	}
+	table.Render()
+}
+
+// planValue returns the value shown in a plan, with "-" for a value that does not exist.
+func planValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,49 @@
+package cmdutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDryRunTestRoot returns a root command with a command that allows --dry-run, one that
+// does not, and one with its own --dry-run flag.
+func newDryRunTestRoot() *cobra.Command {
+	root := &cobra.Command{Use: "asc"}
+	AddDryRunFlag(root)
+	supported := &cobra.Command{Use: "stop", Run: func(*cobra.Command, []string) {}}
+	AllowDryRun(supported)
+	unsupported := &cobra.Command{Use: "terminate", Run: func(*cobra.Command, []string) {}}
+	local := &cobra.Command{Use: "set", Run: func(*cobra.Command, []string) {}}
+	local.Flags().BoolP("dry-run", "n", false, "")
+	root.AddCommand(supported, unsupported, local)
+	return root
+}
+
+func TestCheckDryRun(t *testing.T) {
+	tests := []struct {
+		args    []string
+		dryRun  bool
+		wantErr bool
+	}{
+		{args: []string{"stop", "--dry-run"}, dryRun: true},
+		{args: []string{"terminate"}},
+		{args: []string{"terminate", "--dry-run"}, dryRun: true, wantErr: true},
+		{args: []string{"set", "-n"}, dryRun: true},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			cmd, args, err := newDryRunTestRoot().Find(tt.args)
+			assert.NoError(t, err)
+			assert.NoError(t, cmd.ParseFlags(args))
+			assert.Equal(t, tt.dryRun, IsDryRun(cmd))
+			if tt.wantErr {
+				assert.Error(t, CheckDryRun(cmd))
+			} else {
+				assert.NoError(t, CheckDryRun(cmd))
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package cmdutil
+
+import (
+	"os"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+)
+
+// AddEndpointFlag adds the global --endpoint-url flag to the root command.
+func AddEndpointFlag(root *cobra.Command) {
+	root.PersistentFlags().String("endpoint-url", "",
+		"Call every AWS service at this URL, e.g. http://localhost:4566 for LocalStack (default $ASC_ENDPOINT_URL)")
+}
+
+// ApplyEndpointURL sets the endpoint every AWS service is called at, from --endpoint-url or
+// ASC_ENDPOINT_URL.
+func ApplyEndpointURL(cmd *cobra.Command) error {
+	endpoint := resolvedEndpointURL(cmd)
+	if endpoint != "" {
+		if err := awsutil.ValidateEndpoint(endpoint); err != nil {
+			return err
+		}
+	}
+	awsutil.EndpointURL = endpoint
+	return nil
+}
+
+// resolvedEndpointURL returns the endpoint given by --endpoint-url, or ASC_ENDPOINT_URL. The
+// environment variable takes precedence over a default from the configuration file, as
+// AWS_PROFILE does, but not over the flag given on the command line.
+func resolvedEndpointURL(cmd *cobra.Command) string {
+	flag := cmd.Flags().Lookup("endpoint-url")
+	if flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	if env := os.Getenv("ASC_ENDPOINT_URL"); env != "" {
+		return env
+	}
+	if flag != nil {
+		return flag.Value.String()
+	}
+	return ""
+}
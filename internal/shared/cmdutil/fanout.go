@@ -0,0 +1,298 @@
+package cmdutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/service/profile"
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	Regions     []string
+	AllRegions  bool
+	Profiles    []string
+	AllProfiles bool
+)
+
+// AddTargetFlags adds the --regions, --all-regions, --profiles and --all-profiles flags to the
+// command for listing resources from several regions and accounts at once.
+func AddTargetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&Regions, "regions", nil, "Comma-separated list of regions to list resources from")
+	cmd.Flags().BoolVar(&AllRegions, "all-regions", false, "List resources from all regions enabled for the account")
+	cmd.Flags().StringSliceVar(&Profiles, "profiles", nil, "Comma-separated list of profiles to list resources from. Accepts wildcards (e.g. prod-*)")
+	cmd.Flags().BoolVar(&AllProfiles, "all-profiles", false, "List resources from every profile in the AWS config")
+	cmd.MarkFlagsMutuallyExclusive("regions", "all-regions")
+	cmd.MarkFlagsMutuallyExclusive("profiles", "all-profiles")
+}
+
+// Target is a profile and region that a command is run against.
+type Target struct {
+	Profile string
+	Account string
+	Region  string
+}
+
+// String returns the target as shown in warnings, e.g. "prod/eu-west-1".
+func (t Target) String() string {
+	switch {
+	case t.Profile == "":
+		return t.Region
+	case t.Region == "":
+		return t.Profile
+	default:
+		return t.Profile + "/" + t.Region
+	}
+}
+
+// IsFanOut returns true if the command was asked to run against more than one target.
+func IsFanOut() bool {
+	return len(Regions) > 0 || AllRegions || len(Profiles) > 0 || AllProfiles
+}
+
+// getProfiles returns the profiles selected by the --profiles and --all-profiles flags, or the
+// profile from the persistent flags if neither is set.
+func getProfiles(current string) ([]string, error) {
+	if len(Profiles) == 0 && !AllProfiles {
+		return []string{current}, nil
+	}
+
+	profiles, err := profile.ListProfiles(profile.ListProfilesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list profiles: %w", err)
+	}
+	var names []string
+	for _, p := range profiles {
+		names = append(names, p.Name)
+	}
+	slices.Sort(names)
+	if AllProfiles {
+		return names, nil
+	}
+
+	var matched []string
+	for _, pattern := range Profiles {
+		pattern = strings.TrimSpace(pattern)
+		found := false
+		for _, name := range names {
+			ok, err := path.Match(pattern, name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid profile pattern %q: %w", pattern, err)
+			}
+			if ok {
+				found = true
+				if !slices.Contains(matched, name) {
+					matched = append(matched, name)
+				}
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no profiles match %q", pattern)
+		}
+	}
+	return matched, nil
+}
+
+// getRegions returns the regions selected by the --regions and --all-regions flags for the
+// profile, or the region from the persistent flags if neither is set.
+func getRegions(ctx context.Context, profile string, region string) ([]string, error) {
+	if AllRegions {
+		regions, err := awsutil.ListRegions(ctx, profile, region)
+		if err != nil {
+			return nil, fmt.Errorf("list regions: %w", err)
+		}
+		return regions, nil
+	}
+	if len(Regions) > 0 {
+		return Regions, nil
+	}
+	return []string{region}, nil
+}
+
+// TargetItem is an item fetched from a single target.
+type TargetItem struct {
+	Target Target
+	Item   any
+}
+
+// Results holds the items fetched from every target, in target order.
+type Results struct {
+	Items        []TargetItem
+	multiRegion  bool
+	multiProfile bool
+}
+
+// targetResult is the outcome of fetching from a single target.
+type targetResult struct {
+	target Target
+	items  []any
+	err    error
+}
+
+// FanOut creates a service for each target and calls fetch with it, concurrently with up to
+// awsutil.DefaultConcurrency targets at a time. Targets are every selected profile combined with
+// every selected region. With a single target any error
+// is returned as is. With several targets, errors are reported on stderr without aborting the
+// other targets, and an error is only returned if every target failed. With --rate-limit, calls
+// to each service in a profile and region share a rate limit (see awsutil.RateLimit), so
+// concurrent fetches do not throttle the account.
+func FanOut[S any](cmd *cobra.Command, createService ServiceCreator[S], fetch func(ctx context.Context, svc S) ([]any, error)) (*Results, error) {
+	ctx := cmd.Context()
+	currentProfile, currentRegion := GetPersistentFlags(cmd)
+
+	profiles, err := getProfiles(currentProfile)
+	if err != nil {
+		return nil, err
+	}
+	multiProfile := len(profiles) > 1
+
+	// Failures are kept in the results rather than returned, so that one target failing does
+	// not cancel the others
+	perProfile, err := awsutil.ParallelMap(ctx, 0, profiles, func(ctx context.Context, name string) ([]targetResult, error) {
+		return profileTargets(ctx, name, currentRegion, multiProfile), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var targets []targetResult
+	for _, results := range perProfile {
+		targets = append(targets, results...)
+	}
+
+	targets, err = awsutil.ParallelMap(ctx, 0, targets, func(ctx context.Context, t targetResult) (targetResult, error) {
+		if t.err != nil {
+			return t, nil
+		}
+		svc, err := createService(ctx, t.target.Profile, t.target.Region)
+		if err != nil {
+			t.err = fmt.Errorf("create service: %w", err)
+			return t, nil
+		}
+		t.items, t.err = fetch(ctx, svc)
+		return t, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(targets) == 1 {
+		if targets[0].err != nil {
+			return nil, targets[0].err
+		}
+	} else {
+		var failed []error
+		for _, t := range targets {
+			if t.err != nil {
+				failed = append(failed, fmt.Errorf("%s: %w", t.target, t.err))
+			}
+		}
+		if len(failed) == len(targets) {
+			return nil, errors.Join(failed...)
+		}
+		for _, err := range failed {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}
+
+	results := &Results{
+		multiRegion:  AllRegions || len(Regions) > 1,
+		multiProfile: multiProfile,
+	}
+	for _, t := range targets {
+		for _, item := range t.items {
+			results.Items = append(results.Items, TargetItem{Target: t.target, Item: item})
+		}
+	}
+	return results, nil
+}
+
+// profileTargets returns a target for every selected region of a single profile, or a single
+// failed target if they cannot be looked up. The account ID is looked up when listing from
+// several profiles.
+func profileTargets(ctx context.Context, profile string, region string, withAccount bool) []targetResult {
+	target := Target{Profile: profile}
+	if withAccount {
+		account, err := awsutil.GetAccountID(ctx, profile, region)
+		if err != nil {
+			return []targetResult{{target: target, err: fmt.Errorf("get account: %w", err)}}
+		}
+		target.Account = account
+	}
+
+	regions, err := getRegions(ctx, profile, region)
+	if err != nil {
+		return []targetResult{{target: target, err: err}}
+	}
+
+	results := make([]targetResult, len(regions))
+	for i, r := range regions {
+		results[i].target = target
+		results[i].target.Region = r
+	}
+	return results
+}
+
+// Data returns the items to render.
+func (r *Results) Data() []any {
+	data := make([]any, len(r.Items))
+	for i := range r.Items {
+		data[i] = r.Items[i]
+	}
+	return data
+}
+
+// Fields prepends the Profile and Account columns to the fields when results come from several
+// profiles, and the Region column when they come from several regions.
+func (r *Results) Fields(fields []tablewriter.Field) []tablewriter.Field {
+	var target []tablewriter.Field
+	if r.multiProfile {
+		target = append(target,
+			tablewriter.Field{Name: "Profile", Category: "Target", Visible: true},
+			tablewriter.Field{Name: "Account", Category: "Target", Visible: true},
+		)
+	}
+	if r.multiRegion {
+		target = append(target, tablewriter.Field{Name: "Region", Category: "Target", Visible: true})
+	}
+	return append(target, fields...)
+}
+
+// FieldGetter wraps a service's field getter to read from the fetched items and to
+// provide the Profile, Account and Region columns.
+func (r *Results) FieldGetter(getFieldValue tablewriter.AttributeGetter) tablewriter.AttributeGetter {
+	return func(fieldName string, instance any) (string, error) {
+		item, ok := instance.(TargetItem)
+		if !ok {
+			return getFieldValue(fieldName, instance)
+		}
+		switch {
+		case fieldName == "Profile" && r.multiProfile:
+			return item.Target.Profile, nil
+		case fieldName == "Account" && r.multiProfile:
+			return item.Target.Account, nil
+		case fieldName == "Region" && r.multiRegion:
+			return item.Target.Region, nil
+		}
+		return getFieldValue(fieldName, item.Item)
+	}
+}
+
+// TagGetter wraps a service's tag getter to read from the fetched items.
+func (r *Results) TagGetter(getTagValue tablewriter.TagGetter) tablewriter.TagGetter {
+	if getTagValue == nil {
+		return nil
+	}
+	return func(tagKey string, instance any) (string, error) {
+		if item, ok := instance.(TargetItem); ok {
+			return getTagValue(tagKey, item.Item)
+		}
+		return getTagValue(tagKey, instance)
+	}
+}
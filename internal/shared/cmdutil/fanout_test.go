@@ -0,0 +1,159 @@
+package cmdutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "asc"}
+	cmd.PersistentFlags().String("profile", "dev", "")
+	cmd.PersistentFlags().String("region", "eu-west-1", "")
+	cmd.SetContext(context.Background())
+	return cmd
+}
+
+// createRegion is a service creator that returns the region it was created for.
+func createRegion(_ context.Context, _ string, region string) (string, error) {
+	return region, nil
+}
+
+func TestFanOut(t *testing.T) {
+	t.Cleanup(func() { Regions = nil })
+
+	t.Run("single region", func(t *testing.T) {
+		Regions = nil
+		results, err := FanOut(newTestCommand(), createRegion, func(_ context.Context, region string) ([]any, error) {
+			return []any{region + "-1", region + "-2"}, nil
+		})
+		require.NoError(t, err)
+
+		fields := results.Fields([]tablewriter.Field{{Name: "Name"}})
+		assert.Len(t, fields, 1, "Region column should only be added for several regions")
+		require.Len(t, results.Items, 2)
+		assert.Equal(t, Target{Profile: "dev", Region: "eu-west-1"}, results.Items[0].Target)
+	})
+
+	t.Run("single region error is returned", func(t *testing.T) {
+		Regions = nil
+		_, err := FanOut(newTestCommand(), createRegion, func(_ context.Context, _ string) ([]any, error) {
+			return nil, errors.New("access denied")
+		})
+		assert.EqualError(t, err, "access denied")
+	})
+
+	t.Run("several regions keep target order", func(t *testing.T) {
+		Regions = []string{"us-east-1", "eu-west-1", "ap-southeast-2"}
+		results, err := FanOut(newTestCommand(), createRegion, func(_ context.Context, region string) ([]any, error) {
+			if region == "eu-west-1" {
+				return nil, errors.New("access denied")
+			}
+			return []any{region + "-item"}, nil
+		})
+		require.NoError(t, err)
+
+		fields := results.Fields([]tablewriter.Field{{Name: "Name"}})
+		require.Len(t, fields, 2)
+		assert.Equal(t, "Region", fields[0].Name)
+
+		getter := results.FieldGetter(func(fieldName string, instance any) (string, error) {
+			return instance.(string), nil
+		})
+		var regions, names []string
+		for _, item := range results.Data() {
+			region, err := getter("Region", item)
+			require.NoError(t, err)
+			name, err := getter("Name", item)
+			require.NoError(t, err)
+			regions = append(regions, region)
+			names = append(names, name)
+		}
+		assert.Equal(t, []string{"us-east-1", "ap-southeast-2"}, regions)
+		assert.Equal(t, []string{"us-east-1-item", "ap-southeast-2-item"}, names)
+	})
+
+	t.Run("every region failing is an error", func(t *testing.T) {
+		Regions = []string{"us-east-1", "eu-west-1"}
+		_, err := FanOut(newTestCommand(), createRegion, func(_ context.Context, region string) ([]any, error) {
+			return nil, errors.New("access denied")
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "us-east-1: access denied")
+		assert.Contains(t, err.Error(), "eu-west-1: access denied")
+	})
+
+	t.Run("targets are fetched by a bounded pool", func(t *testing.T) {
+		Regions = nil
+		for i := range 3 * awsutil.DefaultConcurrency {
+			Regions = append(Regions, fmt.Sprintf("region-%d", i))
+		}
+		var inFlight, maxInFlight atomic.Int32
+		results, err := FanOut(newTestCommand(), createRegion, func(_ context.Context, region string) ([]any, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return []any{region}, nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, results.Items, len(Regions))
+		assert.LessOrEqual(t, maxInFlight.Load(), int32(awsutil.DefaultConcurrency))
+		assert.Greater(t, maxInFlight.Load(), int32(1), "targets are fetched concurrently")
+	})
+}
+
+func TestGetProfiles(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".aws"), 0o755))
+	config := "[default]\n[profile prod-eu]\n[profile prod-us]\n[profile staging]\n[sso-session corp]\n"
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".aws", "config"), []byte(config), 0o600))
+	t.Cleanup(func() {
+		Profiles = nil
+		AllProfiles = false
+	})
+
+	tests := []struct {
+		name        string
+		profiles    []string
+		allProfiles bool
+		want        []string
+		wantErr     string
+	}{
+		{name: "current profile", want: []string{"dev"}},
+		{name: "all profiles", allProfiles: true, want: []string{"default", "prod-eu", "prod-us", "staging"}},
+		{name: "wildcard", profiles: []string{"prod-*", "staging"}, want: []string{"prod-eu", "prod-us", "staging"}},
+		{name: "duplicates", profiles: []string{"prod-eu", "prod-*"}, want: []string{"prod-eu", "prod-us"}},
+		{name: "no match", profiles: []string{"qa"}, wantErr: `no profiles match "qa"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Profiles, AllProfiles = tt.profiles, tt.allProfiles
+			got, err := getProfiles("dev")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,14 +3,23 @@ package cmdutil
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
 	"github.com/spf13/cobra"
 )
 
 var (
 	Tags         []string
+	Columns      []string
+	Sort         []string
+	Filters      []string
 	ValidLayouts = []string{"horizontal", "vertical", "grid"}
+
+	// sortFromAliases is set when Sort was filled by flags added with AddSortAlias rather than
+	// by --sort.
+	sortFromAliases bool
 )
 
 // GetPersistentFlags returns the profile and region from the command line flags.
@@ -20,16 +29,125 @@ func GetPersistentFlags(cmd *cobra.Command) (string, string) {
 	return profile, region
 }
 
-// AddTagFlag adds the --tag flag to the command for filtering resources by tags.
-func AddTagFlag(cmd *cobra.Command) {
-	cmd.Flags().StringSliceVar(&Tags, "tags", nil, "Filter resources by tags (key=value)")
-	if err := cmd.RegisterFlagCompletionFunc("tags", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		return nil, cobra.ShellCompDirectiveNoFileComp
-	}); err != nil {
+// AddTagFlag adds the --tags flag to the command for displaying tags as columns. complete
+// completes the tag keys of the resources the command lists, such as one returned by
+// CompleteFlagList.
+func AddTagFlag(cmd *cobra.Command, complete cobra.CompletionFunc) {
+	cmd.Flags().StringSliceVar(&Tags, "tags", nil, "Comma-separated list of tag keys to display as columns")
+	if err := cmd.RegisterFlagCompletionFunc("tags", complete); err != nil {
+		panic(err)
+	}
+}
+
+// FieldsFunc returns the fields a command lists, for completing the names of its columns.
+type FieldsFunc func() []tablewriter.Field
+
+// AddColumnsFlag adds the --columns flag to the command for choosing the columns to display.
+// Any field supported by the service's GetFieldValue can be used, as well as tags (Tag:Key).
+// The names of the fields returned by fields are completed.
+func AddColumnsFlag(cmd *cobra.Command, fields ...FieldsFunc) {
+	cmd.Flags().StringSliceVar(&Columns, "columns", nil, "Comma-separated list of columns to display, in order (e.g. \"Name,Instance ID,Tag:Owner\")")
+	registerFieldCompletion(cmd, "columns", fields, completeFieldList(fields))
+}
+
+// AddSortFlag adds the --sort flag to the command for sorting by one or more columns.
+// Each column can be followed by :asc or :desc, and overrides any other sort flags. The names of
+// the fields returned by fields are completed.
+func AddSortFlag(cmd *cobra.Command, fields ...FieldsFunc) {
+	sortFromAliases = false
+	cmd.Flags().StringSliceVar(&Sort, "sort", nil, "Comma-separated list of columns to sort by, with optional :asc or :desc (e.g. \"Launch Time:desc,Name\")")
+	registerFieldCompletion(cmd, "sort", fields, completeFieldList(fields))
+}
+
+// AddSortAlias adds a hidden, deprecated boolean flag that sorts by the given --sort
+// specification (e.g. "Launch Time:desc"). It is kept for the --sort-<column> flags that
+// predate --sort, which takes precedence when both are given. Setting the alias marks --sort as
+// changed, so that a sort preset in the configuration file does not replace it.
+func AddSortAlias(cmd *cobra.Command, name, shorthand, spec string) {
+	cmd.Flags().VarPF(&sortAlias{cmd: cmd, spec: spec}, name, shorthand, fmt.Sprintf("Sort by %s.", spec)).NoOptDefVal = "true"
+	if err := cmd.Flags().MarkDeprecated(name, fmt.Sprintf("use --sort %q instead", spec)); err != nil {
 		panic(err)
 	}
 }
 
+// sortAlias is the value of a flag added by AddSortAlias.
+type sortAlias struct {
+	cmd   *cobra.Command
+	spec  string
+	value bool
+}
+
+func (a *sortAlias) String() string {
+	return strconv.FormatBool(a.value)
+}
+
+func (a *sortAlias) Set(s string) error {
+	value, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	a.value = value
+	sort := a.cmd.Flags().Lookup("sort")
+	if !value || (sort.Changed && !sortFromAliases) {
+		return nil
+	}
+	// Sort is appended to directly, so a later --sort still replaces it
+	Sort = append(Sort, a.spec)
+	sort.Changed = true
+	sortFromAliases = true
+	return nil
+}
+
+func (a *sortAlias) Type() string {
+	return "bool"
+}
+
+// AddFilterFlag adds the --filter flag to the command for only listing the resources that match
+// a filter expression. The flag can be repeated, and every filter must match. The names of the
+// fields returned by fields are completed.
+func AddFilterFlag(cmd *cobra.Command, fields ...FieldsFunc) {
+	cmd.Flags().StringArrayVar(&Filters, "filter", nil, "Only list resources matching the expression, e.g. State=running, \"Instance Type\"~^m5, Tag:Env!=prod or \"Size\">100. Can be repeated")
+	registerFieldCompletion(cmd, "filter", fields, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		// Only the field is completed, up to the operator
+		if strings.ContainsAny(toComplete, "=!~<>") {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return filterCompletions(fieldNames(fields), nil, toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	})
+}
+
+// registerFieldCompletion registers complete for a flag of cmd. Without any fields, no
+// completion is registered.
+func registerFieldCompletion(cmd *cobra.Command, name string, fields []FieldsFunc, complete cobra.CompletionFunc) {
+	if len(fields) == 0 {
+		return
+	}
+	if err := cmd.RegisterFlagCompletionFunc(name, complete); err != nil {
+		panic(err)
+	}
+}
+
+// completeFieldList completes a comma-separated list of the names of the fields returned by
+// fields.
+func completeFieldList(fields []FieldsFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeList(fieldNames(fields), toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// fieldNames returns the names of the fields returned by each of fields, without duplicates.
+func fieldNames(fields []FieldsFunc) []string {
+	var names []string
+	for _, fn := range fields {
+		for _, field := range fn() {
+			if !slices.Contains(names, field.Name) {
+				names = append(names, field.Name)
+			}
+		}
+	}
+	return names
+}
+
 // AddShowFlags adds shared flags for the show command with a configurable default layout.
 func AddShowFlags(cmd *cobra.Command, defaultLayout string) {
 	cmd.Flags().StringP("output", "o", defaultLayout, fmt.Sprintf("Output format (%s)", strings.Join(ValidLayouts, ", ")))
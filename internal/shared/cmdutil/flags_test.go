@@ -0,0 +1,123 @@
+package cmdutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/shared/config"
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Unit test for AddSortAlias
+func TestAddSortAlias(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "no sort", args: nil, want: nil},
+		{name: "alias", args: []string{"-t"}, want: []string{"Launch Time:desc"}},
+		{name: "long alias", args: []string{"--sort-id"}, want: []string{"Instance ID"}},
+		{name: "aliases combine", args: []string{"-t", "-i"}, want: []string{"Launch Time:desc", "Instance ID"}},
+		{name: "alias set to false", args: []string{"--sort-id=false"}, want: nil},
+		{name: "sort after alias", args: []string{"-t", "--sort", "Name"}, want: []string{"Name"}},
+		{name: "sort before alias", args: []string{"--sort", "Name", "-t"}, want: []string{"Name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "ls"}
+			AddSortFlag(cmd)
+			AddSortAlias(cmd, "sort-id", "i", "Instance ID")
+			AddSortAlias(cmd, "sort-launch-time", "t", "Launch Time:desc")
+			var out bytes.Buffer
+			cmd.Flags().SetOutput(&out)
+
+			require.NoError(t, cmd.ParseFlags(tt.args))
+			assert.Equal(t, tt.want, Sort)
+		})
+	}
+}
+
+// Unit test for sort aliases taking precedence over a sort preset in the configuration file
+func TestAddSortAliasConfig(t *testing.T) {
+	cfg := &config.Config{Commands: map[string]config.CommandConfig{
+		"ls": {Sort: []string{"Name"}},
+	}}
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "preset", args: nil, want: []string{"Name"}},
+		{name: "alias", args: []string{"-t"}, want: []string{"Launch Time:desc"}},
+		{name: "sort", args: []string{"--sort", "Instance ID"}, want: []string{"Instance ID"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "ls"}
+			AddSortFlag(cmd)
+			AddSortAlias(cmd, "sort-launch-time", "t", "Launch Time:desc")
+			cmd.Flags().SetOutput(&bytes.Buffer{})
+
+			require.NoError(t, cmd.ParseFlags(tt.args))
+			require.NoError(t, cfg.Apply(cmd))
+			assert.Equal(t, tt.want, Sort)
+		})
+	}
+}
+
+// Unit test for the deprecation notice and help of sort aliases
+func TestAddSortAliasDeprecated(t *testing.T) {
+	cmd := &cobra.Command{Use: "ls"}
+	AddSortFlag(cmd)
+	AddSortAlias(cmd, "sort-launch-time", "t", "Launch Time:desc")
+	var out bytes.Buffer
+	cmd.Flags().SetOutput(&out)
+
+	require.NoError(t, cmd.ParseFlags([]string{"-t"}))
+	assert.Contains(t, out.String(), `use --sort "Launch Time:desc" instead`)
+	assert.NotContains(t, cmd.Flags().FlagUsages(), "sort-launch-time")
+}
+
+// Unit test for completing the field names of --columns, --sort and --filter
+func TestFieldCompletion(t *testing.T) {
+	fields := func() []tablewriter.Field {
+		return []tablewriter.Field{{Name: "Name"}, {Name: "Instance ID"}, {Name: "Instance Type"}}
+	}
+	rules := func() []tablewriter.Field {
+		return []tablewriter.Field{{Name: "Name"}, {Name: "Rule"}}
+	}
+	cmd := &cobra.Command{Use: "ls"}
+	AddColumnsFlag(cmd, fields, rules)
+	AddSortFlag(cmd, fields)
+	AddFilterFlag(cmd, fields)
+
+	tests := []struct {
+		flag       string
+		toComplete string
+		want       []string
+	}{
+		{flag: "columns", toComplete: "", want: []string{"Name", "Instance ID", "Instance Type", "Rule"}},
+		{flag: "columns", toComplete: "Name,Inst", want: []string{"Name,Instance ID", "Name,Instance Type"}},
+		{flag: "sort", toComplete: "Instance ID,", want: []string{"Instance ID,Name", "Instance ID,Instance Type"}},
+		{flag: "filter", toComplete: "Inst", want: []string{"Instance ID", "Instance Type"}},
+		{flag: "filter", toComplete: "Name=", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.flag+" "+tt.toComplete, func(t *testing.T) {
+			complete, ok := cmd.GetFlagCompletionFunc(tt.flag)
+			require.True(t, ok)
+			got, _ := complete(cmd, nil, tt.toComplete)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// Without fields, no completion is registered
+	cmd = &cobra.Command{Use: "ls"}
+	AddColumnsFlag(cmd)
+	_, ok := cmd.GetFlagCompletionFunc("columns")
+	assert.False(t, ok)
+}
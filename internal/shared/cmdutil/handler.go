@@ -2,23 +2,197 @@ package cmdutil
 
 import (
 	"errors"
-	"log"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/aws/smithy-go"
+)
+
+// Exit codes returned by asc. Errors without an ExitError exit with ExitCodeError.
+const (
+	ExitCodeError              = 1
+	ExitCodeWaitFailed         = 2
+	ExitCodeWaitTimeout        = 3
+	ExitCodeNotFound           = 4
+	ExitCodeAccessDenied       = 5
+	ExitCodeThrottled          = 6
+	ExitCodeValidation         = 7
+	ExitCodeExpiredCredentials = 8
 )
 
-// DefaultErrorHandler handles AWS smithy.OperationError, context timeouts, and can be extended for other error types.
+// ExitError is an error that exits the process with a specific exit code. Hint is an optional
+// suggestion for fixing the error, shown below it.
+type ExitError struct {
+	Code int
+	Err  error
+	Hint string
+}
+
+func (e *ExitError) Error() string { return e.Err.Error() }
+
+func (e *ExitError) Unwrap() error { return e.Err }
+
+// ExitCode returns the exit code for an error.
+func ExitCode(err error) int {
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return ExitCodeError
+}
+
+// hintProfile is the profile named in hints, set by SetHintProfile.
+var hintProfile string
+
+// SetHintProfile sets the AWS profile named in hints such as `aws sso login --profile X`.
+func SetHintProfile(profile string) {
+	hintProfile = profile
+}
+
+// DefaultErrorHandler translates an error returned by a command into an ExitError with an exit
+// code and hint for common AWS API errors. Other errors are returned unchanged. The error is
+// printed by main, so that cobra and deferred functions finish first.
 func DefaultErrorHandler(err error) error {
 	if err == nil {
 		return nil
 	}
-	// Print your context and the root cause
-	var unwrapped error = err
-	for {
-		if next := errors.Unwrap(unwrapped); next != nil {
-			unwrapped = next
-		} else {
-			break
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return err
+	}
+	if code, hint := classify(err); code != ExitCodeError {
+		return &ExitError{Code: code, Err: err, Hint: hint}
+	}
+	return err
+}
+
+// PrintError writes an error and its hint, if any, to w.
+func PrintError(w io.Writer, err error) {
+	fmt.Fprintf(w, "Error: %v\n", err)
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) && exitErr.Hint != "" {
+		fmt.Fprintf(w, "Hint: %s\n", exitErr.Hint)
+	}
+}
+
+// Error codes returned by AWS APIs, grouped by the exit code they map to.
+var (
+	expiredCodes = map[string]bool{
+		"ExpiredToken":          true,
+		"ExpiredTokenException": true,
+		"RequestExpired":        true,
+		"InvalidGrantException": true,
+		"UnauthorizedException": true,
+	}
+	accessDeniedCodes = map[string]bool{
+		"AccessDenied":          true,
+		"AccessDeniedException": true,
+		"UnauthorizedOperation": true,
+		"AuthorizationError":    true,
+		"Forbidden":             true,
+	}
+	throttledCodes = map[string]bool{
+		"Throttling":                             true,
+		"ThrottlingException":                    true,
+		"ThrottledException":                     true,
+		"RequestThrottled":                       true,
+		"RequestThrottledException":              true,
+		"RequestLimitExceeded":                   true,
+		"TooManyRequestsException":               true,
+		"ProvisionedThroughputExceededException": true,
+		"SlowDown":                               true,
+	}
+	validationCodes = map[string]bool{
+		"ValidationError":                true,
+		"ValidationException":            true,
+		"InvalidParameter":               true,
+		"InvalidParameterValue":          true,
+		"InvalidParameterCombination":    true,
+		"InvalidParameterException":      true,
+		"InvalidParameterValueException": true,
+		"MissingParameter":               true,
+		"InvalidInput":                   true,
+	}
+)
+
+// Messages of errors raised by the SDK before a request is sent, when credentials cannot be loaded.
+var credentialMessages = []string{
+	"refresh cached SSO token failed",
+	"the SSO session has expired",
+	"failed to refresh cached credentials",
+}
+
+// iamActionPattern matches the action named in an access denied message,
+// e.g. "is not authorized to perform: ec2:DescribeInstances".
+var iamActionPattern = regexp.MustCompile(`perform: ([a-zA-Z0-9-]+:[a-zA-Z0-9]+)`)
+
+// iamPrefixes maps smithy service IDs to IAM action prefixes where they differ.
+var iamPrefixes = map[string]string{
+	"Elastic Load Balancing v2": "elasticloadbalancing",
+	"EFS":                       "elasticfilesystem",
+	"Auto Scaling":              "autoscaling",
+}
+
+// classify returns the exit code and hint for an error.
+func classify(err error) (int, string) {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		for _, message := range credentialMessages {
+			if strings.Contains(err.Error(), message) {
+				return ExitCodeExpiredCredentials, credentialsHint()
+			}
 		}
+		return ExitCodeError, ""
+	}
+
+	code := apiErr.ErrorCode()
+	switch {
+	case expiredCodes[code]:
+		return ExitCodeExpiredCredentials, credentialsHint()
+	case accessDeniedCodes[code]:
+		return ExitCodeAccessDenied, accessDeniedHint(err, apiErr)
+	case throttledCodes[code]:
+		return ExitCodeThrottled, "AWS is throttling requests. Wait and retry, or reduce the number of regions and profiles queried at once."
+	case strings.Contains(code, "NotFound") || code == "NoSuchEntity" ||
+		(code == "ValidationError" && strings.Contains(apiErr.ErrorMessage(), "does not exist")):
+		return ExitCodeNotFound, "Check the resource name, and that --profile and --region point at the account and region it is in."
+	case validationCodes[code]:
+		return ExitCodeValidation, "AWS rejected the request. Check the arguments and flags against `--help`."
+	}
+	return ExitCodeError, ""
+}
+
+// credentialsHint suggests how to refresh the credentials of the profile in use.
+func credentialsHint() string {
+	profile := hintProfile
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	if profile == "" {
+		return "Your AWS credentials have expired or are missing. Run `aws sso login` or `aws configure` and retry."
+	}
+	return fmt.Sprintf("Your AWS credentials have expired or are missing. Run `aws sso login --profile %s` and retry.", profile)
+}
+
+// accessDeniedHint names the IAM action that was denied, taken from the error message or
+// otherwise from the service and operation that failed.
+func accessDeniedHint(err error, apiErr smithy.APIError) string {
+	action := ""
+	var opErr *smithy.OperationError
+	if match := iamActionPattern.FindStringSubmatch(apiErr.ErrorMessage()); match != nil {
+		action = match[1]
+	} else if errors.As(err, &opErr) {
+		prefix, ok := iamPrefixes[opErr.ServiceID]
+		if !ok {
+			prefix = strings.ToLower(strings.ReplaceAll(opErr.ServiceID, " ", ""))
+		}
+		action = prefix + ":" + opErr.OperationName
+	}
+	if action == "" {
+		return "Your IAM identity is not allowed to make this request. Check its policies."
 	}
-	log.Fatalf("Error: %v\n", err) // This should include your prefix
-	return nil              // Prevent Cobra from printing again
+	return fmt.Sprintf("Your IAM identity is not allowed to call %s. Add it to a policy attached to the identity, or use a different --profile.", action)
 }
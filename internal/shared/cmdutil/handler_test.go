@@ -0,0 +1,103 @@
+package cmdutil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// apiError returns an error as returned by an AWS SDK client, wrapped by a command.
+func apiError(service, operation, code, message string) error {
+	return fmt.Errorf("get instances: %w", &smithy.OperationError{
+		ServiceID:     service,
+		OperationName: operation,
+		Err:           &smithy.GenericAPIError{Code: code, Message: message},
+	})
+}
+
+func TestDefaultErrorHandler(t *testing.T) {
+	SetHintProfile("prod")
+	defer SetHintProfile("")
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantHint string
+	}{
+		{
+			name:     "expired token",
+			err:      apiError("EC2", "DescribeInstances", "ExpiredToken", "The security token included in the request is expired"),
+			wantCode: ExitCodeExpiredCredentials,
+			wantHint: "aws sso login --profile prod",
+		},
+		{
+			name:     "expired SSO session",
+			err:      errors.New("get identity: get credentials: failed to refresh cached credentials, refresh cached SSO token failed"),
+			wantCode: ExitCodeExpiredCredentials,
+			wantHint: "aws sso login --profile prod",
+		},
+		{
+			name:     "access denied with action in message",
+			err:      apiError("EC2", "DescribeInstances", "UnauthorizedOperation", "You are not authorized to perform: ec2:DescribeInstances"),
+			wantCode: ExitCodeAccessDenied,
+			wantHint: "ec2:DescribeInstances",
+		},
+		{
+			name:     "access denied without action in message",
+			err:      apiError("Elastic Load Balancing v2", "DescribeLoadBalancers", "AccessDenied", "Access denied"),
+			wantCode: ExitCodeAccessDenied,
+			wantHint: "elasticloadbalancing:DescribeLoadBalancers",
+		},
+		{
+			name:     "not found",
+			err:      apiError("RDS", "DescribeDBInstances", "DBInstanceNotFound", "DBInstance my-db not found"),
+			wantCode: ExitCodeNotFound,
+		},
+		{
+			name:     "CloudFormation stack does not exist",
+			err:      apiError("CloudFormation", "DescribeStacks", "ValidationError", "Stack with id my-stack does not exist"),
+			wantCode: ExitCodeNotFound,
+		},
+		{
+			name:     "throttled",
+			err:      apiError("ECS", "DescribeServices", "ThrottlingException", "Rate exceeded"),
+			wantCode: ExitCodeThrottled,
+		},
+		{
+			name:     "validation",
+			err:      apiError("EC2", "DescribeInstances", "InvalidParameterValue", "Invalid value"),
+			wantCode: ExitCodeValidation,
+		},
+		{
+			name:     "other error",
+			err:      errors.New("something went wrong"),
+			wantCode: ExitCodeError,
+		},
+		{
+			name:     "exit error is unchanged",
+			err:      &ExitError{Code: ExitCodeWaitTimeout, Err: errors.New("timed out")},
+			wantCode: ExitCodeWaitTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DefaultErrorHandler(tt.err)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.wantCode, ExitCode(err))
+
+			var buf bytes.Buffer
+			PrintError(&buf, err)
+			assert.Contains(t, buf.String(), "Error: "+tt.err.Error())
+			if tt.wantHint != "" {
+				assert.Contains(t, buf.String(), tt.wantHint)
+			}
+		})
+	}
+
+	assert.NoError(t, DefaultErrorHandler(nil))
+}
@@ -0,0 +1,92 @@
+package cmdutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// webhookTimeout is the maximum time to wait for a webhook to respond.
+const webhookTimeout = 10 * time.Second
+
+// WaitResult is the outcome of waiting on a resource, passed to the --exec and --notify-url hooks.
+type WaitResult struct {
+	URI      string
+	Status   string
+	Result   string
+	Duration time.Duration
+	Success  bool
+}
+
+// MarshalJSON encodes the result with the duration in seconds.
+func (r WaitResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		URI      string  `json:"uri"`
+		Status   string  `json:"status"`
+		Result   string  `json:"result"`
+		Duration float64 `json:"duration_seconds"`
+		Success  bool    `json:"success"`
+	}{r.URI, r.Status, r.Result, r.Duration.Seconds(), r.Success})
+}
+
+// Env returns the result as environment variables for a hook command.
+func (r WaitResult) Env() []string {
+	return []string{
+		"ASC_WAIT_URI=" + r.URI,
+		"ASC_WAIT_STATUS=" + r.Status,
+		"ASC_WAIT_RESULT=" + r.Result,
+		"ASC_WAIT_DURATION=" + strconv.Itoa(int(r.Duration.Seconds())),
+		"ASC_WAIT_SUCCESS=" + strconv.FormatBool(r.Success),
+	}
+}
+
+// RunHook runs a shell command with the result in its environment. The command's output is
+// passed through to stdout and stderr.
+func RunHook(ctx context.Context, command string, result WaitResult) error {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	cmd := exec.CommandContext(ctx, shell, flag, command)
+	cmd.Env = append(os.Environ(), result.Env()...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run %q: %w", command, err)
+	}
+	return nil
+}
+
+// PostWebhook sends the result to a URL as a JSON POST request.
+func PostWebhook(ctx context.Context, url string, result WaitResult) error {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "asc")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("notify %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("notify %s: %s", url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package cmdutil
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testResult = WaitResult{
+	URI:      "cf://stack/my-stack",
+	Status:   "CREATE_COMPLETE",
+	Result:   "succeeded",
+	Duration: 90 * time.Second,
+	Success:  true,
+}
+
+func TestPostWebhook(t *testing.T) {
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer server.Close()
+
+	require.NoError(t, PostWebhook(context.Background(), server.URL, testResult))
+	assert.Equal(t, map[string]any{
+		"uri":              "cf://stack/my-stack",
+		"status":           "CREATE_COMPLETE",
+		"result":           "succeeded",
+		"duration_seconds": float64(90),
+		"success":          true,
+	}, got)
+}
+
+func TestPostWebhookError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := PostWebhook(context.Background(), server.URL, testResult)
+	assert.ErrorContains(t, err, "500")
+}
+
+func TestRunHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook test uses a POSIX shell")
+	}
+	out := filepath.Join(t.TempDir(), "out")
+	err := RunHook(context.Background(), `echo "$ASC_WAIT_URI $ASC_WAIT_STATUS $ASC_WAIT_DURATION $ASC_WAIT_SUCCESS" > `+out, testResult)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "cf://stack/my-stack CREATE_COMPLETE 90 true\n", string(data))
+
+	assert.Error(t, RunHook(context.Background(), "exit 1", testResult))
+}
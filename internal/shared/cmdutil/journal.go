@@ -0,0 +1,116 @@
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/shared/journal"
+	"github.com/spf13/cobra"
+)
+
+// RecordChange adds a change made by a command to the journal, with the profile, region, role
+// and endpoint it was made in and the command line that made it. target is the ResourceURI of the changed
+// resource, undo names the handler that can reverse the change (empty if it cannot be undone),
+// and before is the state of the resource before the change.
+//
+// The change has already been made, so a failure to write the journal is reported as a warning
+// rather than failing the command.
+func RecordChange(cmd *cobra.Command, target, undo string, before map[string]string) {
+	recordEntry(cmd, journal.Entry{Target: target, Undo: undo, Before: before})
+}
+
+// RecordUndo adds the reversal of a journal entry to the journal, in the profile, region, role
+// and endpoint of the entry. The reversal can itself be undone with the given handler.
+func RecordUndo(cmd *cobra.Command, of journal.Entry, undo string, before map[string]string) {
+	recordEntry(cmd, journal.Entry{
+		Profile:    of.Profile,
+		Region:     of.Region,
+		RoleARN:    of.RoleARN,
+		ExternalID: of.ExternalID,
+		Endpoint:   of.Endpoint,
+		Target:     of.Target,
+		Undo:       undo,
+		Before:     before,
+		UndoOf:     of.ID,
+	})
+}
+
+// CheckEntryScope returns an error if a command does not run with the role and endpoint a journal
+// entry was made with, so that a change is never undone in another account or at another
+// endpoint.
+func CheckEntryScope(cmd *cobra.Command, entry journal.Entry) error {
+	role := resolvedAssumeRole(cmd)
+	checks := []struct {
+		flag, entry, current string
+	}{
+		{"role-arn", entry.RoleARN, role.RoleARN},
+		{"external-id", entry.ExternalID, role.ExternalID},
+		{"endpoint-url", entry.Endpoint, resolvedEndpointURL(cmd)},
+	}
+	for _, check := range checks {
+		if check.entry == check.current {
+			continue
+		}
+		if check.entry == "" {
+			return fmt.Errorf("change %d was made without --%s, but undo is running with --%s %s",
+				entry.ID, check.flag, check.flag, check.current)
+		}
+		return fmt.Errorf("change %d was made with --%s %s. Run undo with the same --%s",
+			entry.ID, check.flag, check.entry, check.flag)
+	}
+	return nil
+}
+
+// redacted holds the arguments replaced in the command lines written to the journal.
+var redacted = map[string]bool{}
+
+// RedactArg replaces an argument, such as a parameter value, with a mask in the command line
+// written to the journal.
+func RedactArg(value string) {
+	redacted[value] = true
+}
+
+func recordEntry(cmd *cobra.Command, entry journal.Entry) {
+	profile, region := resolvedProfileRegion(cmd)
+	entry.Profile = firstNonEmpty(entry.Profile, profile)
+	entry.Region = firstNonEmpty(entry.Region, region)
+
+	args := []string{cmd.Root().Name()}
+	for _, arg := range os.Args[1:] {
+		if redacted[arg] {
+			arg = "********"
+		}
+		args = append(args, arg)
+	}
+	entry.Command = strings.Join(args, " ")
+
+	if entry.UndoOf == 0 {
+		role := resolvedAssumeRole(cmd)
+		entry.RoleARN = role.RoleARN
+		entry.ExternalID = role.ExternalID
+		entry.Endpoint = resolvedEndpointURL(cmd)
+	}
+
+	if _, err := journal.Append(entry); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not record change in journal: %v\n", err)
+	}
+}
+
+// resolvedProfileRegion returns the profile and region a command runs in, from its flags or
+// otherwise the AWS environment variables.
+func resolvedProfileRegion(cmd *cobra.Command) (string, string) {
+	profile, region := GetPersistentFlags(cmd)
+	return firstNonEmpty(profile, os.Getenv("AWS_PROFILE")),
+		firstNonEmpty(region, os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"))
+}
+
+// firstNonEmpty returns the first of values that is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,51 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/shared/journal"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for CheckEntryScope
+func TestCheckEntryScope(t *testing.T) {
+	const role = "arn:aws:iam::123456789012:role/customer"
+	tests := []struct {
+		name    string
+		entry   journal.Entry
+		flags   []string
+		wantErr string
+	}{
+		{name: "no role or endpoint", entry: journal.Entry{ID: 1}},
+		{name: "same role", entry: journal.Entry{ID: 1, RoleARN: role}, flags: []string{"--role-arn", role}},
+		{name: "same role and external ID", entry: journal.Entry{ID: 1, RoleARN: role, ExternalID: "abc"}, flags: []string{"--role-arn", role, "--external-id", "abc"}},
+		{name: "same endpoint", entry: journal.Entry{ID: 1, Endpoint: "http://localhost:4566"}, flags: []string{"--endpoint-url", "http://localhost:4566"}},
+		{name: "role missing", entry: journal.Entry{ID: 1, RoleARN: role},
+			wantErr: "change 1 was made with --role-arn " + role + ". Run undo with the same --role-arn"},
+		{name: "role not recorded", entry: journal.Entry{ID: 2}, flags: []string{"--role-arn", role},
+			wantErr: "change 2 was made without --role-arn, but undo is running with --role-arn " + role},
+		{name: "other external ID", entry: journal.Entry{ID: 1, RoleARN: role, ExternalID: "abc"}, flags: []string{"--role-arn", role, "--external-id", "def"},
+			wantErr: "change 1 was made with --external-id abc. Run undo with the same --external-id"},
+		{name: "endpoint not recorded", entry: journal.Entry{ID: 3}, flags: []string{"--endpoint-url", "http://localhost:4566"},
+			wantErr: "change 3 was made without --endpoint-url, but undo is running with --endpoint-url http://localhost:4566"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ASC_ENDPOINT_URL", "")
+			root := &cobra.Command{Use: "asc"}
+			AddAssumeRoleFlags(root)
+			AddEndpointFlag(root)
+			cmd := &cobra.Command{Use: "undo"}
+			root.AddCommand(cmd)
+			assert.NoError(t, cmd.ParseFlags(tt.flags))
+
+			err := CheckEntryScope(cmd, tt.entry)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
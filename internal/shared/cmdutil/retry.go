@@ -0,0 +1,41 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/spf13/cobra"
+)
+
+// AddRetryFlags adds the global --retry-mode, --max-attempts and --rate-limit flags to the root
+// command.
+func AddRetryFlags(root *cobra.Command) {
+	root.PersistentFlags().String("retry-mode", "", "SDK retry mode: standard or adaptive (default from AWS_RETRY_MODE or the AWS config, or standard)")
+	root.PersistentFlags().Int("max-attempts", 0, "Maximum number of attempts for each AWS call, including retries (default from AWS_MAX_ATTEMPTS or the AWS config, or 3)")
+	root.PersistentFlags().Float64("rate-limit", awsutil.DefaultRateLimit, "Maximum AWS requests per second to each service in a profile and region (0 for no limit)")
+}
+
+// ApplyRetryFlags sets how AWS calls are retried and rate limited, from --retry-mode,
+// --max-attempts and --rate-limit.
+func ApplyRetryFlags(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	mode, _ := flags.GetString("retry-mode")
+	maxAttempts, _ := flags.GetInt("max-attempts")
+	rateLimit, _ := flags.GetFloat64("rate-limit")
+
+	if mode != "" {
+		if err := awsutil.ParseRetryMode(mode); err != nil {
+			return err
+		}
+	}
+	if maxAttempts < 0 {
+		return fmt.Errorf("invalid value for max-attempts flag: %d. Must be 0 or greater", maxAttempts)
+	}
+	if rateLimit < 0 {
+		return fmt.Errorf("invalid value for rate-limit flag: %g. Must be 0 or greater", rateLimit)
+	}
+	awsutil.RetryMode = mode
+	awsutil.MaxAttempts = maxAttempts
+	awsutil.RateLimit = rateLimit
+	return nil
+}
@@ -0,0 +1,194 @@
+package cmdutil
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/selection"
+	"github.com/spf13/cobra"
+)
+
+// LoadSelection returns the resources selected for the profile, region, role and endpoint of a
+// command.
+func LoadSelection(cmd *cobra.Command) ([]*awsutil.ResourceURI, error) {
+	return selection.Load(selectionScope(cmd))
+}
+
+// SaveSelection replaces the resources selected for the profile, region, role and endpoint of a
+// command.
+func SaveSelection(cmd *cobra.Command, uris []*awsutil.ResourceURI) error {
+	return selection.Save(selectionScope(cmd), uris)
+}
+
+// selectionScope returns the scope a command's selection is kept in.
+func selectionScope(cmd *cobra.Command) selection.Scope {
+	profile, region := resolvedProfileRegion(cmd)
+	role := resolvedAssumeRole(cmd)
+	return selection.Scope{
+		Profile:    profile,
+		Region:     region,
+		RoleARN:    role.RoleARN,
+		ExternalID: role.ExternalID,
+		Endpoint:   resolvedEndpointURL(cmd),
+	}
+}
+
+// ResolveSelection replaces "@" in args with the URIs of the selected resources. If args is
+// empty, the selected resources are returned.
+func ResolveSelection(cmd *cobra.Command, args []string) ([]string, error) {
+	if len(args) > 0 && !slices.Contains(args, selection.Placeholder) {
+		return args, nil
+	}
+	uris, err := loadRequiredSelection(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved []string
+	for _, arg := range args {
+		if arg != selection.Placeholder {
+			resolved = append(resolved, arg)
+		}
+	}
+	for _, uri := range uris {
+		resolved = append(resolved, uri.String())
+	}
+	return resolved, nil
+}
+
+// SelectedIDs replaces "@" in args with the identifiers of the selected resources of the given
+// service and type, such as "ec2" and "instance". If args is empty, the identifiers of the
+// selected resources of that type are returned. Selected resources of other types are ignored.
+func SelectedIDs(cmd *cobra.Command, args []string, service, resourceType string) ([]string, error) {
+	if len(args) > 0 && !slices.Contains(args, selection.Placeholder) {
+		return args, nil
+	}
+	uris, err := loadRequiredSelection(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, arg := range args {
+		if arg != selection.Placeholder {
+			ids = append(ids, arg)
+		}
+	}
+	found := false
+	for _, uri := range uris {
+		if uri.Service == service && uri.ResourceType == resourceType {
+			ids = append(ids, uri.Resource)
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no %s %s resources are selected", service, resourceType)
+	}
+	return ids, nil
+}
+
+// confirmInput is where confirmations are read from, replaced in tests.
+var confirmInput io.Reader = os.Stdin
+
+// AddYesFlag adds the --yes flag, which skips the confirmation of ConfirmSelectedIDs and
+// ConfirmSelection.
+func AddYesFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Act on the selected resources without confirmation")
+}
+
+// ConfirmSelectedIDs is SelectedIDs for commands that change the state of resources, such as
+// stopping or terminating instances. The selection is only used when "@" is passed, never when
+// args is empty, and the resolved identifiers are listed for confirmation unless --yes or
+// --dry-run is set. It returns false if args is empty or the user declines.
+func ConfirmSelectedIDs(cmd *cobra.Command, args []string, service, resourceType, action string) ([]string, bool, error) {
+	if len(args) == 0 {
+		return nil, false, nil
+	}
+	ids, err := SelectedIDs(cmd, args, service, resourceType)
+	if err != nil {
+		return nil, false, err
+	}
+	if !slices.Contains(args, selection.Placeholder) {
+		return ids, true, nil
+	}
+	ok, err := confirmSelected(cmd, ids, resourceType, action)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return ids, true, nil
+}
+
+// ConfirmSelection is ResolveSelection for commands that change resources of any type, such as
+// tagging them. The selection is only used when "@" is passed, never when args is empty, and
+// the resolved URIs are listed for confirmation unless --yes or --dry-run is set. It returns
+// false if the user declines.
+func ConfirmSelection(cmd *cobra.Command, args []string, action string) ([]string, bool, error) {
+	if !slices.Contains(args, selection.Placeholder) {
+		return args, true, nil
+	}
+	resources, err := ResolveSelection(cmd, args)
+	if err != nil {
+		return nil, false, err
+	}
+	ok, err := confirmSelected(cmd, resources, "", action)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return resources, true, nil
+}
+
+// confirmSelected lists the resources resolved from the selection and asks to confirm the
+// action, unless --yes or --dry-run is set. resourceType names the type of the resources, or is
+// empty for resources of any type.
+func confirmSelected(cmd *cobra.Command, resources []string, resourceType, action string) (bool, error) {
+	yes, _ := cmd.Flags().GetBool("yes")
+	if yes || IsDryRun(cmd) {
+		return true, nil
+	}
+
+	kind := "resources"
+	if resourceType != "" {
+		kind = resourceType + " resources"
+	}
+	fmt.Printf("The following %s will be affected:\n", kind)
+	for _, resource := range resources {
+		fmt.Printf("  - %s\n", resource)
+	}
+	if resourceType != "" {
+		return Confirm(fmt.Sprintf("\n%s %d %s resource(s)?", action, len(resources), resourceType))
+	}
+	return Confirm(fmt.Sprintf("\n%s %d resource(s)?", action, len(resources)))
+}
+
+// Confirm asks a yes or no question, and returns true if the user answers yes. Any other answer
+// prints "Aborted." and returns false.
+func Confirm(prompt string) (bool, error) {
+	fmt.Print(prompt + " [y/N]: ")
+	response, err := bufio.NewReader(confirmInput).ReadString('\n')
+	if err != nil && response == "" {
+		return false, fmt.Errorf("read confirmation: %w", err)
+	}
+	response = strings.TrimSpace(strings.ToLower(response))
+	if response != "y" && response != "yes" {
+		fmt.Println("Aborted.")
+		return false, nil
+	}
+	return true, nil
+}
+
+// loadRequiredSelection returns the selected resources, or an error if none are selected.
+func loadRequiredSelection(cmd *cobra.Command) ([]*awsutil.ResourceURI, error) {
+	uris, err := LoadSelection(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(uris) == 0 {
+		return nil, fmt.Errorf("no resource given and no resources are selected. Pass a resource or run 'asc select'")
+	}
+	return uris, nil
+}
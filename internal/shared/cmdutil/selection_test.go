@@ -0,0 +1,111 @@
+package cmdutil
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/selection"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmSelectedIDs(t *testing.T) {
+	t.Setenv("ASC_SELECTION", filepath.Join(t.TempDir(), "selection.json"))
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_REGION", "eu-west-1")
+	var uris []*awsutil.ResourceURI
+	for _, id := range []string{"i-0abc", "i-0def"} {
+		uri, err := awsutil.ParseResourceURI(id)
+		require.NoError(t, err)
+		uris = append(uris, uri)
+	}
+	require.NoError(t, selection.Save(selection.Scope{Region: "eu-west-1"}, uris))
+
+	tests := []struct {
+		name    string
+		args    []string
+		flags   []string
+		input   string
+		wantIDs []string
+		wantOK  bool
+	}{
+		{name: "no arguments never use the selection", args: nil},
+		{name: "explicit identifiers are not confirmed", args: []string{"i-0123"}, wantIDs: []string{"i-0123"}, wantOK: true},
+		{name: "selection confirmed", args: []string{"@"}, input: "y\n", wantIDs: []string{"i-0abc", "i-0def"}, wantOK: true},
+		{name: "selection declined", args: []string{"@"}, input: "\n"},
+		{name: "selection with --yes", args: []string{"@"}, flags: []string{"--yes"}, wantIDs: []string{"i-0abc", "i-0def"}, wantOK: true},
+		{name: "selection with --dry-run", args: []string{"@"}, flags: []string{"--dry-run"}, wantIDs: []string{"i-0abc", "i-0def"}, wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := confirmInput
+			confirmInput = strings.NewReader(tt.input)
+			t.Cleanup(func() { confirmInput = input })
+
+			root := &cobra.Command{Use: "asc"}
+			AddDryRunFlag(root)
+			cmd := &cobra.Command{Use: "terminate"}
+			AddYesFlag(cmd)
+			root.AddCommand(cmd)
+			require.NoError(t, cmd.ParseFlags(tt.flags))
+
+			ids, ok, err := ConfirmSelectedIDs(cmd, tt.args, "ec2", "instance", "Terminate")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestConfirmSelection(t *testing.T) {
+	t.Setenv("ASC_SELECTION", filepath.Join(t.TempDir(), "selection.json"))
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_REGION", "eu-west-1")
+	var uris []*awsutil.ResourceURI
+	for _, id := range []string{"i-0abc", "rds://my-db"} {
+		uri, err := awsutil.ParseResourceURI(id)
+		require.NoError(t, err)
+		uris = append(uris, uri)
+	}
+	require.NoError(t, selection.Save(selection.Scope{Region: "eu-west-1"}, uris))
+	selected := []string{"ec2://instance/i-0abc", "rds://instance/my-db"}
+
+	tests := []struct {
+		name   string
+		args   []string
+		flags  []string
+		input  string
+		want   []string
+		wantOK bool
+	}{
+		{name: "no arguments never use the selection", args: nil, wantOK: true},
+		{name: "explicit resources are not confirmed", args: []string{"sg-0abc"}, want: []string{"sg-0abc"}, wantOK: true},
+		{name: "selection confirmed", args: []string{"@"}, input: "yes\n", want: selected, wantOK: true},
+		{name: "selection with other resources", args: []string{"sg-0abc", "@"}, input: "y\n", want: append([]string{"sg-0abc"}, selected...), wantOK: true},
+		{name: "selection declined", args: []string{"@"}, input: "n\n"},
+		{name: "selection with --yes", args: []string{"@"}, flags: []string{"--yes"}, want: selected, wantOK: true},
+		{name: "selection with --dry-run", args: []string{"@"}, flags: []string{"--dry-run"}, want: selected, wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := confirmInput
+			confirmInput = strings.NewReader(tt.input)
+			t.Cleanup(func() { confirmInput = input })
+
+			root := &cobra.Command{Use: "asc"}
+			AddDryRunFlag(root)
+			cmd := &cobra.Command{Use: "tag"}
+			AddYesFlag(cmd)
+			root.AddCommand(cmd)
+			require.NoError(t, cmd.ParseFlags(tt.flags))
+
+			resources, ok, err := ConfirmSelection(cmd, tt.args, "Tag")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, resources)
+		})
+	}
+}
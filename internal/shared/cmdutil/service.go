@@ -2,6 +2,7 @@ package cmdutil
 
 import (
 	"context"
+	"errors"
 
 	"github.com/spf13/cobra"
 )
@@ -9,6 +10,10 @@ import (
 type ServiceCreator[T any] func(ctx context.Context, profile string, region string) (T, error)
 
 func CreateService[T any](cmd *cobra.Command, createService ServiceCreator[T]) (T, error) {
+	if IsFanOut() {
+		var zero T
+		return zero, errors.New("--regions, --all-regions, --profiles and --all-profiles are not supported here, use --region and --profile instead")
+	}
 	ctx := cmd.Context()
 	profile, region := GetPersistentFlags(cmd)
 	return createService(ctx, profile, region)
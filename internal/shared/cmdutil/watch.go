@@ -0,0 +1,174 @@
+package cmdutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// DefaultWatchInterval is the interval used by --watch when no interval is given.
+const DefaultWatchInterval = 5 * time.Second
+
+// ParseInterval parses a watch interval given as a duration (e.g. 30s, 1m) or a number of
+// seconds (e.g. 10, 0.5).
+func ParseInterval(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		seconds, numErr := strconv.ParseFloat(s, 64)
+		if numErr != nil {
+			return 0, fmt.Errorf("invalid interval %q, expected a duration (e.g. 30s) or a number of seconds", s)
+		}
+		d = time.Duration(seconds * float64(time.Second))
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid interval %q, must be greater than zero", s)
+	}
+	return d, nil
+}
+
+// watchValue is the value of the --watch flag. The flag can be given without an interval, in
+// which case implicit is set and the interval may follow as the next argument, at index in the
+// command's arguments.
+type watchValue struct {
+	flags    *pflag.FlagSet
+	interval time.Duration
+	implicit bool
+	index    int
+}
+
+// watchDefault is the value set when --watch is given without "=interval". It is not a valid
+// interval, so it cannot be confused with one given explicitly.
+const watchDefault = "default"
+
+func (v *watchValue) String() string {
+	if v.interval == 0 {
+		return ""
+	}
+	return v.interval.String()
+}
+
+func (v *watchValue) Set(s string) error {
+	if s == watchDefault {
+		// The arguments parsed so far are those before --watch, so the next one is at NArg
+		v.interval, v.implicit, v.index = DefaultWatchInterval, true, v.flags.NArg()
+		return nil
+	}
+	d, err := ParseInterval(s)
+	if err != nil {
+		return err
+	}
+	v.interval, v.implicit = d, false
+	return nil
+}
+
+func (v *watchValue) Type() string {
+	return "interval"
+}
+
+// resolve returns the arguments and interval to use. When --watch was given without
+// "=interval", the argument immediately after it is taken as the interval if it is one, so that
+// both --watch=10s and --watch 10s work. Other arguments are never taken as the interval.
+func (v *watchValue) resolve(args []string) ([]string, time.Duration) {
+	if !v.implicit || v.index >= len(args) {
+		return args, v.interval
+	}
+	d, err := ParseInterval(args[v.index])
+	if err != nil {
+		return args, v.interval
+	}
+	rest := append(append([]string{}, args[:v.index]...), args[v.index+1:]...)
+	return rest, d
+}
+
+// AddWatchFlags adds the --watch flag to every list and show command below cmd. List and show
+// commands are the commands named ls or show, and their subcommands.
+func AddWatchFlags(cmd *cobra.Command) {
+	addWatchFlags(cmd, false)
+}
+
+func addWatchFlags(cmd *cobra.Command, parentWatchable bool) {
+	watchable := parentWatchable || cmd.Name() == "ls" || cmd.Name() == "show"
+	if watchable && cmd.RunE != nil && cmd.Flags().Lookup("watch") == nil {
+		addWatchFlag(cmd)
+	}
+	for _, sub := range cmd.Commands() {
+		addWatchFlags(sub, watchable)
+	}
+}
+
+// addWatchFlag adds the --watch flag to the command and wraps its argument validation and run
+// function to re-run the command on the interval.
+func addWatchFlag(cmd *cobra.Command) {
+	value := &watchValue{flags: cmd.Flags()}
+	flag := cmd.Flags().VarPF(value, "watch", "",
+		"Re-run the command every interval and highlight changes (e.g. --watch=10s)")
+	flag.NoOptDefVal = watchDefault
+
+	validateArgs, run := cmd.Args, cmd.RunE
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		args, _ = value.resolve(args)
+		if validateArgs == nil {
+			return nil
+		}
+		return validateArgs(cmd, args)
+	}
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		args, interval := value.resolve(args)
+		if interval == 0 {
+			return run(cmd, args)
+		}
+		title := strings.Join(append([]string{cmd.CommandPath()}, args...), " ")
+		return Watch(cmd.Context(), title, interval, func() error {
+			return run(cmd, args)
+		})
+	}
+}
+
+// Watch calls run every interval until interrupted, redrawing the screen with its output each
+// time. Table cells that changed since the previous run are highlighted. Errors are shown
+// below the output and do not stop the watch. Machine-readable output is written without
+// clearing the screen, so that it can be piped.
+func Watch(ctx context.Context, title string, interval time.Duration, run func() error) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	tablewriter.HighlightChanges()
+
+	for {
+		var buf bytes.Buffer
+		tablewriter.Output = &buf
+		err := run()
+		tablewriter.Output = os.Stdout
+
+		if !tablewriter.IsMachineReadable() {
+			fmt.Printf("\033[H\033[2J%s\n\n", watchHeader(title, interval))
+		}
+		os.Stdout.Write(buf.Bytes())
+		if err != nil {
+			PrintError(os.Stderr, DefaultErrorHandler(err))
+		} else {
+			tablewriter.NextFrame()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// watchHeader returns the line shown above each frame, e.g.
+// "Every 5s: asc ecs task ls --cluster prod    12:04:05".
+func watchHeader(title string, interval time.Duration) string {
+	return fmt.Sprintf("Every %s: %s    %s", interval, title, time.Now().Format(time.TimeOnly))
+}
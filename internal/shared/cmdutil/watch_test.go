@@ -0,0 +1,65 @@
+package cmdutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "10s", want: 10 * time.Second},
+		{input: "1m", want: time.Minute},
+		{input: "10", want: 10 * time.Second},
+		{input: "0.5", want: 500 * time.Millisecond},
+		{input: "0", wantErr: true},
+		{input: "-5s", wantErr: true},
+		{input: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseInterval(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWatchValueResolve(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		wantArgs     []string
+		wantInterval time.Duration
+	}{
+		{name: "interval after --watch is taken from the arguments", args: []string{"my-cluster", "--watch", "10s"}, wantArgs: []string{"my-cluster"}, wantInterval: 10 * time.Second},
+		{name: "interval after --watch before other arguments", args: []string{"--watch", "10", "my-cluster"}, wantArgs: []string{"my-cluster"}, wantInterval: 10 * time.Second},
+		{name: "no interval", args: []string{"my-cluster", "--watch"}, wantArgs: []string{"my-cluster"}, wantInterval: DefaultWatchInterval},
+		{name: "only the argument after --watch is the interval", args: []string{"--watch", "my-cluster", "10"}, wantArgs: []string{"my-cluster", "10"}, wantInterval: DefaultWatchInterval},
+		{name: "arguments before --watch are not the interval", args: []string{"10", "--watch"}, wantArgs: []string{"10"}, wantInterval: DefaultWatchInterval},
+		{name: "arguments are left alone with --watch=interval", args: []string{"my-cluster", "--watch=1m", "10"}, wantArgs: []string{"my-cluster", "10"}, wantInterval: time.Minute},
+		{name: "an explicit default interval is not a bare --watch", args: []string{"my-cluster", "--watch=" + DefaultWatchInterval.String(), "10"}, wantArgs: []string{"my-cluster", "10"}, wantInterval: DefaultWatchInterval},
+		{name: "no --watch", args: []string{"my-cluster", "10"}, wantArgs: []string{"my-cluster", "10"}, wantInterval: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "ls", RunE: func(cmd *cobra.Command, args []string) error { return nil }}
+			addWatchFlag(cmd)
+			require.NoError(t, cmd.ParseFlags(tt.args))
+			args, interval := cmd.Flags().Lookup("watch").Value.(*watchValue).resolve(cmd.Flags().Args())
+			assert.Equal(t, tt.wantArgs, args)
+			assert.Equal(t, tt.wantInterval, interval)
+		})
+	}
+}
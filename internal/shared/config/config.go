@@ -0,0 +1,232 @@
+// Package config loads the user configuration file and applies it as defaults for command flags.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the contents of the configuration file.
+//
+//	profile: prod
+//	region: eu-west-1
+//	style: rounded-separated
+//	endpoints:
+//	  ssm: http://localhost:5000
+//	flags:
+//	  list: true
+//	commands:
+//	  ec2 ls:
+//	    columns: [Name, Instance ID, State, Launch Time]
+//	    sort: ["Launch Time:desc"]
+//	    flags:
+//	      private-ip: true
+type Config struct {
+	Profile   string                   `yaml:"profile"`
+	Region    string                   `yaml:"region"`
+	Style     string                   `yaml:"style"`
+	Format    string                   `yaml:"format"`
+	Endpoints map[string]string        `yaml:"endpoints"`
+	Flags     map[string]any           `yaml:"flags"`
+	Commands  map[string]CommandConfig `yaml:"commands"`
+}
+
+// CommandConfig holds the defaults for a single command, keyed by its path (e.g. "ec2 ls").
+type CommandConfig struct {
+	Columns []string       `yaml:"columns"`
+	Sort    []string       `yaml:"sort"`
+	Flags   map[string]any `yaml:"flags"`
+}
+
+// Path returns the location of the configuration file. ASC_CONFIG overrides the default of
+// $XDG_CONFIG_HOME/asc/config.yaml, or ~/.config/asc/config.yaml if XDG_CONFIG_HOME is not set.
+func Path() (string, error) {
+	if path := os.Getenv("ASC_CONFIG"); path != "" {
+		return path, nil
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "asc", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "asc", "config.yaml"), nil
+}
+
+// StateDir returns the directory asc keeps its state in, such as the journal of changes:
+// $XDG_STATE_HOME/asc, or ~/.local/state/asc if XDG_STATE_HOME is not set.
+func StateDir() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "asc"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(home, ".local", "state", "asc"), nil
+}
+
+// CacheDir returns the directory asc caches data in, such as shell completion results:
+// $XDG_CACHE_HOME/asc, or ~/.cache/asc if XDG_CACHE_HOME is not set.
+func CacheDir() (string, error) {
+	if dir := os.Getenv("XDG_CACHE_HOME"); dir != "" {
+		return filepath.Join(dir, "asc"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(home, ".cache", "asc"), nil
+}
+
+// Load reads the configuration file. A missing file returns an empty configuration.
+func Load() (*Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Apply sets the configured values as defaults for the flags of the command being run.
+// Flags set on the command line are left alone, as are the profile and region when they are
+// set through the AWS environment variables. Global flags are skipped by commands that do not
+// have them, while unknown flags for a specific command are an error.
+func (c *Config) Apply(cmd *cobra.Command) error {
+	if c.Profile != "" && os.Getenv("AWS_PROFILE") == "" {
+		if err := setDefault(cmd.Flags(), "profile", c.Profile); err != nil {
+			return err
+		}
+	}
+	if c.Region != "" && os.Getenv("AWS_REGION") == "" && os.Getenv("AWS_DEFAULT_REGION") == "" {
+		if err := setDefault(cmd.Flags(), "region", c.Region); err != nil {
+			return err
+		}
+	}
+	if c.Format != "" {
+		if err := setDefault(cmd.Flags(), "format", c.Format); err != nil {
+			return err
+		}
+	}
+
+	for name, value := range c.Flags {
+		if cmd.Flags().Lookup(name) == nil {
+			continue
+		}
+		if err := setDefault(cmd.Flags(), name, value); err != nil {
+			return err
+		}
+	}
+
+	path := commandPath(cmd)
+	cmdCfg, ok := c.Commands[path]
+	if !ok {
+		return nil
+	}
+
+	flags := map[string]any{}
+	for name, value := range cmdCfg.Flags {
+		flags[name] = value
+	}
+	if len(cmdCfg.Columns) > 0 {
+		flags["columns"] = cmdCfg.Columns
+	}
+	if len(cmdCfg.Sort) > 0 {
+		flags["sort"] = cmdCfg.Sort
+	}
+
+	for name, value := range flags {
+		if cmd.Flags().Lookup(name) == nil {
+			return fmt.Errorf("config: unknown flag %q for command %q", name, path)
+		}
+		if err := setDefault(cmd.Flags(), name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDefault sets the value of a flag that was not set on the command line. The flag is not
+// marked as changed, so the value behaves like the flag's default.
+func setDefault(flags *pflag.FlagSet, name string, value any) error {
+	flag := flags.Lookup(name)
+	if flag == nil || flag.Changed {
+		return nil
+	}
+
+	if slice, ok := flag.Value.(pflag.SliceValue); ok {
+		if values, ok := flagSlice(value); ok {
+			if err := slice.Replace(values); err != nil {
+				return fmt.Errorf("config: invalid value for flag %q: %w", name, err)
+			}
+			return nil
+		}
+	}
+	if err := flag.Value.Set(flagString(value)); err != nil {
+		return fmt.Errorf("config: invalid value for flag %q: %w", name, err)
+	}
+	return nil
+}
+
+// flagString converts a configuration value to the string form accepted by a flag.
+// Lists are joined with commas, as accepted by slice flags.
+func flagString(value any) string {
+	if values, ok := value.([]any); ok {
+		parts := make([]string, len(values))
+		for i, v := range values {
+			parts[i] = fmt.Sprint(v)
+		}
+		return strings.Join(parts, ",")
+	}
+	if values, ok := value.([]string); ok {
+		return strings.Join(values, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+// flagSlice converts a configuration list to the values of a slice flag. Each entry is kept as
+// a single value, so entries may contain commas.
+func flagSlice(value any) ([]string, bool) {
+	switch values := value.(type) {
+	case []string:
+		return values, true
+	case []any:
+		out := make([]string, len(values))
+		for i, v := range values {
+			out[i] = fmt.Sprint(v)
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+// commandPath returns the path of the command without the root command name (e.g. "ec2 ls").
+func commandPath(cmd *cobra.Command) string {
+	path := cmd.CommandPath()
+	if root := cmd.Root().Name(); strings.HasPrefix(path, root+" ") {
+		return strings.TrimPrefix(path, root+" ")
+	}
+	return path
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCommand creates a root and ls command with flags similar to the list commands.
+func newTestCommand() (*cobra.Command, *cobra.Command) {
+	root := &cobra.Command{Use: "asc"}
+	root.PersistentFlags().String("profile", "", "")
+	service := &cobra.Command{Use: "ec2"}
+	ls := &cobra.Command{Use: "ls", Run: func(cmd *cobra.Command, args []string) {}}
+	ls.Flags().BoolP("list", "l", false, "")
+	ls.Flags().StringSlice("columns", nil, "")
+	root.AddCommand(service)
+	service.AddCommand(ls)
+	return root, ls
+}
+
+// Unit test for Load and Apply
+func TestApply(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+profile: prod
+flags:
+  list: true
+  missing: true
+commands:
+  ec2 ls:
+    columns: [Name, Instance ID]
+`), 0o600))
+	t.Setenv("ASC_CONFIG", path)
+	t.Setenv("AWS_PROFILE", "")
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+
+	root, ls := newTestCommand()
+	root.SetArgs([]string{"ec2", "ls", "--list=false"})
+	assert.NoError(t, root.Execute())
+	assert.NoError(t, cfg.Apply(ls))
+
+	profile, _ := ls.Flags().GetString("profile")
+	list, _ := ls.Flags().GetBool("list")
+	columns, _ := ls.Flags().GetStringSlice("columns")
+	assert.Equal(t, "prod", profile)
+	assert.False(t, list, "flags set on the command line take precedence")
+	assert.Equal(t, []string{"Name", "Instance ID"}, columns)
+}
+
+// Unit test for Apply with an unknown command flag
+func TestApplyUnknownFlag(t *testing.T) {
+	cfg := &Config{Commands: map[string]CommandConfig{
+		"ec2 ls": {Flags: map[string]any{"bogus": true}},
+	}}
+
+	root, ls := newTestCommand()
+	root.SetArgs([]string{"ec2", "ls"})
+	assert.NoError(t, root.Execute())
+	assert.Error(t, cfg.Apply(ls))
+}
+
+// Unit test for Load with a missing file
+func TestLoadMissing(t *testing.T) {
+	t.Setenv("ASC_CONFIG", filepath.Join(t.TempDir(), "missing.yaml"))
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{}, cfg)
+}
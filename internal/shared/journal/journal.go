@@ -0,0 +1,178 @@
+// Package journal records the changes made by asc in an append-only local file, so they can be
+// reviewed with `asc history` and reversed with `asc undo`.
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/harleymckenzie/asc/internal/shared/config"
+)
+
+// Entry is a single change made by asc.
+//
+// Before holds the state of the resource before the change, captured from the describe call the
+// command made. Undo is the name of the undo handler that can restore it, and is empty for
+// changes that cannot be undone. RoleARN, ExternalID and Endpoint record the role assumed and the
+// endpoint called, so that the change is undone in the same account.
+type Entry struct {
+	ID         int               `json:"id"`
+	Time       time.Time         `json:"time"`
+	Profile    string            `json:"profile,omitempty"`
+	Region     string            `json:"region,omitempty"`
+	RoleARN    string            `json:"role_arn,omitempty"`
+	ExternalID string            `json:"external_id,omitempty"`
+	Endpoint   string            `json:"endpoint_url,omitempty"`
+	Command    string            `json:"command"`
+	Target     string            `json:"target"`
+	Undo       string            `json:"undo,omitempty"`
+	Before     map[string]string `json:"before,omitempty"`
+	UndoOf     int               `json:"undo_of,omitempty"` // ID of the entry this change undid
+}
+
+// Path returns the location of the journal. ASC_JOURNAL overrides the default of
+// $XDG_STATE_HOME/asc/journal.jsonl, or ~/.local/state/asc/journal.jsonl if XDG_STATE_HOME is
+// not set.
+func Path() (string, error) {
+	if path := os.Getenv("ASC_JOURNAL"); path != "" {
+		return path, nil
+	}
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "journal.jsonl"), nil
+}
+
+// Load reads all entries from the journal, oldest first. A missing journal returns no entries.
+func Load() ([]Entry, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	return load(path)
+}
+
+func load(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+	defer file.Close()
+	return read(file, path)
+}
+
+// read reads the entries from an open journal.
+func read(file *os.File, path string) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("parse journal %s line %d: %w", path, line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read journal: %w", err)
+	}
+	return entries, nil
+}
+
+// Append adds an entry to the end of the journal, setting its ID to one more than the last
+// entry, and returns it.
+func Append(entry Entry) (Entry, error) {
+	path, err := Path()
+	if err != nil {
+		return entry, err
+	}
+	return appendEntry(path, entry)
+}
+
+func appendEntry(path string, entry Entry) (Entry, error) {
+	// The journal names accounts and resources, so it is only readable by the user
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return entry, fmt.Errorf("create journal directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return entry, fmt.Errorf("open journal: %w", err)
+	}
+	defer file.Close()
+
+	// The journal is locked while the last ID is read and the entry written, so that concurrent
+	// commands do not record entries with the same ID
+	if err := lockFile(file); err != nil {
+		return entry, fmt.Errorf("lock journal: %w", err)
+	}
+	defer unlockFile(file)
+
+	entries, err := read(file, path)
+	if err != nil {
+		return entry, err
+	}
+	entry.ID = 1
+	if len(entries) > 0 {
+		entry.ID = entries[len(entries)-1].ID + 1
+	}
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return entry, err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return entry, fmt.Errorf("write journal: %w", err)
+	}
+	return entry, nil
+}
+
+// Find returns the entry with the given ID.
+func Find(entries []Entry, id int) (Entry, bool) {
+	for _, entry := range entries {
+		if entry.ID == id {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
+
+// UndoneBy maps the ID of each entry that has been undone to the ID of the entry that undid it.
+func UndoneBy(entries []Entry) map[int]int {
+	undone := make(map[int]int)
+	for _, entry := range entries {
+		if entry.UndoOf != 0 {
+			undone[entry.UndoOf] = entry.ID
+		}
+	}
+	return undone
+}
+
+// LastUndoable returns the most recent entry that can be undone and has not been.
+func LastUndoable(entries []Entry) (Entry, bool) {
+	undone := UndoneBy(entries)
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Undo != "" && entry.UndoOf == 0 {
+			if _, ok := undone[entry.ID]; !ok {
+				return entry, true
+			}
+		}
+	}
+	return Entry{}, false
+}
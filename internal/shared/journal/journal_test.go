@@ -0,0 +1,126 @@
+package journal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendAndLoad(t *testing.T) {
+	t.Setenv("ASC_JOURNAL", filepath.Join(t.TempDir(), "state", "journal.jsonl"))
+
+	entries, err := Load()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	first, err := Append(Entry{Command: "asc ec2 stop i-123", Target: "ec2://instance/i-123", Undo: "ec2/stop",
+		Before: map[string]string{"state": "running"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.ID)
+	assert.False(t, first.Time.IsZero())
+
+	second, err := Append(Entry{Command: "asc ec2 terminate i-456", Target: "ec2://instance/i-456"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.ID)
+
+	entries, err = Load()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "running", entries[0].Before["state"])
+	assert.Equal(t, "asc ec2 terminate i-456", entries[1].Command)
+
+	path, err := Path()
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestAppendConcurrent(t *testing.T) {
+	t.Setenv("ASC_JOURNAL", filepath.Join(t.TempDir(), "journal.jsonl"))
+
+	const n = 20
+	ids := make([]int, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			entry, err := Append(Entry{Command: fmt.Sprintf("asc ec2 stop i-%d", i), Target: "ec2://instance/i-123"})
+			assert.NoError(t, err)
+			ids[i] = entry.ID
+		}()
+	}
+	wg.Wait()
+
+	slices.Sort(ids)
+	for i, id := range ids {
+		assert.Equal(t, i+1, id, "each entry has its own ID")
+	}
+	entries, err := Load()
+	require.NoError(t, err)
+	assert.Len(t, entries, n)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	t.Setenv("ASC_JOURNAL", path)
+	require.NoError(t, os.WriteFile(path, []byte("{\"id\":1}\nnot json\n"), 0o600))
+
+	_, err := Load()
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestLastUndoable(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []Entry
+		wantID  int
+		wantOK  bool
+	}{
+		{
+			name:    "empty",
+			entries: nil,
+		},
+		{
+			name: "skips entries that cannot be undone",
+			entries: []Entry{
+				{ID: 1, Undo: "ec2/stop"},
+				{ID: 2},
+			},
+			wantID: 1,
+			wantOK: true,
+		},
+		{
+			name: "skips undone entries and undo entries",
+			entries: []Entry{
+				{ID: 1, Undo: "asg/modify"},
+				{ID: 2, Undo: "ec2/stop"},
+				{ID: 3, Undo: "ec2/start", UndoOf: 2},
+			},
+			wantID: 1,
+			wantOK: true,
+		},
+		{
+			name: "nothing left to undo",
+			entries: []Entry{
+				{ID: 1, Undo: "ec2/stop"},
+				{ID: 2, Undo: "ec2/start", UndoOf: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, ok := LastUndoable(tt.entries)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantID, entry.ID)
+		})
+	}
+}
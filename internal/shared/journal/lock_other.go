@@ -0,0 +1,16 @@
+//go:build !unix
+
+package journal
+
+import "os"
+
+// lockFile does nothing where flock is not available, so concurrent commands may record
+// entries with the same ID.
+func lockFile(file *os.File) error {
+	return nil
+}
+
+// unlockFile releases the lock taken by lockFile.
+func unlockFile(file *os.File) error {
+	return nil
+}
@@ -0,0 +1,47 @@
+//go:build unix
+
+package journal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendWaitsForLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	t.Setenv("ASC_JOURNAL", path)
+
+	// Hold the lock as another asc process appending an entry would
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	require.NoError(t, err)
+	defer file.Close()
+	require.NoError(t, lockFile(file))
+
+	done := make(chan Entry)
+	go func() {
+		entry, err := Append(Entry{Command: "asc ec2 stop i-123", Target: "ec2://instance/i-123"})
+		assert.NoError(t, err)
+		done <- entry
+	}()
+	select {
+	case <-done:
+		t.Fatal("Append did not wait for the journal lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	_, err = file.WriteString(`{"id":1,"command":"asc ec2 start i-456","target":"ec2://instance/i-456"}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, unlockFile(file))
+
+	select {
+	case entry := <-done:
+		assert.Equal(t, 2, entry.ID, "the ID follows the entry written while the lock was held")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Append did not finish after the lock was released")
+	}
+}
@@ -0,0 +1,123 @@
+// Package selection stores the resources selected with `asc select`, so that later commands can
+// act on them without repeating their identifiers. A selection is kept for each profile, region,
+// assumed role and endpoint.
+package selection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/harleymckenzie/asc/internal/shared/config"
+)
+
+// Placeholder is the argument that stands for the selected resources.
+const Placeholder = "@"
+
+// Path returns the location of the selection file. ASC_SELECTION overrides the default of
+// selection.json in the state directory.
+func Path() (string, error) {
+	if path := os.Getenv("ASC_SELECTION"); path != "" {
+		return path, nil
+	}
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "selection.json"), nil
+}
+
+// Scope is the account and endpoint a selection is made in. Selections are kept separately for
+// each scope, so that "@" never resolves to the resources of another account or endpoint.
+type Scope struct {
+	Profile    string
+	Region     string
+	RoleARN    string // Role assumed with --role-arn, if any
+	ExternalID string // External ID passed when assuming the role
+	Endpoint   string // Endpoint set with --endpoint-url, if any
+}
+
+// key returns the key a selection is stored under. Selections made without a role or endpoint
+// are keyed by "profile/region" alone.
+func (s Scope) key() string {
+	profile := s.Profile
+	if profile == "" {
+		profile = "default"
+	}
+	parts := []string{profile + "/" + s.Region}
+	if s.RoleARN != "" {
+		parts = append(parts, "role="+s.RoleARN)
+	}
+	if s.ExternalID != "" {
+		parts = append(parts, "external-id="+s.ExternalID)
+	}
+	if s.Endpoint != "" {
+		parts = append(parts, "endpoint="+s.Endpoint)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Load returns the resources selected in a scope.
+func Load(scope Scope) ([]*awsutil.ResourceURI, error) {
+	selections, err := loadAll()
+	if err != nil {
+		return nil, err
+	}
+	return selections[scope.key()], nil
+}
+
+// Save replaces the resources selected in a scope. An empty selection is removed.
+func Save(scope Scope, uris []*awsutil.ResourceURI) error {
+	selections, err := loadAll()
+	if err != nil {
+		return err
+	}
+	if len(uris) == 0 {
+		delete(selections, scope.key())
+	} else {
+		selections[scope.key()] = uris
+	}
+	return saveAll(selections)
+}
+
+func loadAll() (map[string][]*awsutil.ResourceURI, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	selections := map[string][]*awsutil.ResourceURI{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return selections, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read selection: %w", err)
+	}
+	if err := json.Unmarshal(data, &selections); err != nil {
+		return nil, fmt.Errorf("parse selection %s: %w", path, err)
+	}
+	return selections, nil
+}
+
+func saveAll(selections map[string][]*awsutil.ResourceURI) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(selections, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create selection directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write selection: %w", err)
+	}
+	return nil
+}
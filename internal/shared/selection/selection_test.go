@@ -0,0 +1,56 @@
+package selection
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/harleymckenzie/asc/internal/shared/awsutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	t.Setenv("ASC_SELECTION", filepath.Join(t.TempDir(), "selection.json"))
+	prod := Scope{Profile: "prod", Region: "eu-west-1"}
+
+	uris, err := Load(prod)
+	require.NoError(t, err)
+	assert.Empty(t, uris)
+
+	service, err := awsutil.ParseResourceURI("ecs://service/my-cluster/my-svc")
+	require.NoError(t, err)
+	instance, err := awsutil.ParseResourceURI("i-0abc")
+	require.NoError(t, err)
+	require.NoError(t, Save(prod, []*awsutil.ResourceURI{service, instance}))
+	require.NoError(t, Save(Scope{Region: "us-east-1"}, []*awsutil.ResourceURI{instance}))
+
+	uris, err = Load(prod)
+	require.NoError(t, err)
+	require.Len(t, uris, 2)
+	assert.Equal(t, service, uris[0])
+	assert.Equal(t, "ec2://instance/i-0abc", uris[1].String())
+
+	// Selections are kept separately for each profile and region
+	uris, err = Load(Scope{Profile: "default", Region: "us-east-1"})
+	require.NoError(t, err)
+	assert.Len(t, uris, 1)
+	uris, err = Load(Scope{Profile: "prod", Region: "us-east-1"})
+	require.NoError(t, err)
+	assert.Empty(t, uris)
+
+	// and for each role and endpoint
+	for _, scope := range []Scope{
+		{Profile: "prod", Region: "eu-west-1", RoleARN: "arn:aws:iam::123456789012:role/customer"},
+		{Profile: "prod", Region: "eu-west-1", RoleARN: "arn:aws:iam::123456789012:role/customer", ExternalID: "abc"},
+		{Profile: "prod", Region: "eu-west-1", Endpoint: "http://localhost:4566"},
+	} {
+		uris, err = Load(scope)
+		require.NoError(t, err)
+		assert.Empty(t, uris, "%+v", scope)
+	}
+
+	require.NoError(t, Save(prod, nil))
+	uris, err = Load(prod)
+	require.NoError(t, err)
+	assert.Empty(t, uris)
+}
@@ -2,6 +2,8 @@ package tablewriter
 
 import (
 	"fmt"
+	"os"
+
 	"github.com/jedib0t/go-pretty/v6/table"
 )
 
@@ -139,6 +141,11 @@ func (at *AscTable) SetColumnWidth(minWidth int, maxWidth int) {
 }
 
 func (dt *DetailTable) Render() {
+	if IsMachineReadable() {
+		dt.renderExport()
+		return
+	}
+
 	table := NewAscWriter(dt.Options)
 
 	// Add headers
@@ -187,6 +194,25 @@ func (dt *DetailTable) Render() {
 	table.Render()
 }
 
+// renderExport writes the detail table in the current machine-readable format.
+// Tables built from sections are written as an object keyed by section title,
+// and tables built from a header and rows are written as a list of records.
+func (dt *DetailTable) renderExport() {
+	var err error
+	if len(dt.Sections) > 0 {
+		err = WriteSections(Output, Format, dt.Sections)
+	} else {
+		rows := make([][]string, 0, len(dt.Rows))
+		for _, row := range dt.Rows {
+			rows = append(rows, row.Values)
+		}
+		err = WriteRecords(Output, Format, dt.Headers, rows)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error writing %s output: %v\n", Format, err)
+	}
+}
+
 func PopulateFieldValues(instance any, fields []Field, getFieldValue AttributeGetter) ([]Field, error) {
 	var populated []Field
 	for _, field := range fields {
@@ -0,0 +1,237 @@
+package tablewriter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat is the format used when rendering list and detail tables.
+type OutputFormat string
+
+// OutputFormat constants.
+const (
+	FormatTable OutputFormat = "table"
+	FormatJSON  OutputFormat = "json"
+	FormatYAML  OutputFormat = "yaml"
+	FormatCSV   OutputFormat = "csv"
+	FormatTSV   OutputFormat = "tsv"
+)
+
+// ValidFormats lists the accepted values for the global --format flag.
+var ValidFormats = []string{
+	string(FormatTable),
+	string(FormatJSON),
+	string(FormatYAML),
+	string(FormatCSV),
+	string(FormatTSV),
+}
+
+// Format is the output format used by every table render. It defaults to a go-pretty table
+// and is set once from the global --format flag.
+var Format = FormatTable
+
+// SetFormat validates and sets the output format used by every table render.
+func SetFormat(format string) error {
+	if !slices.Contains(ValidFormats, format) {
+		return fmt.Errorf("invalid choice for format flag: %s. Valid options: %s", format, strings.Join(ValidFormats, ", "))
+	}
+	Format = OutputFormat(format)
+	return nil
+}
+
+// IsMachineReadable returns true if the current output format is not a table.
+func IsMachineReadable() bool {
+	return Format != FormatTable
+}
+
+// record is a single exported row. Keys keep the column order of the table.
+type record struct {
+	keys   []string
+	values []string
+}
+
+// MarshalJSON writes the record as a JSON object, preserving column order.
+func (r record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range r.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(r.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// yamlNode returns the record as a YAML mapping node, preserving column order.
+func (r record) yamlNode() *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for i, key := range r.keys {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: r.values[i]},
+		)
+	}
+	return node
+}
+
+// namedRecord is a record with a name, used to export the sections of a detail table.
+type namedRecord struct {
+	name string
+	record
+}
+
+// cleanValue removes the ANSI colour codes added by format.Status and friends.
+func cleanValue(value string) string {
+	return text.StripEscape(value)
+}
+
+// WriteRecords writes the rows of a list table to w in the given format.
+// The headers are used as keys (JSON, YAML) or as the header line (CSV, TSV).
+func WriteRecords(w io.Writer, format OutputFormat, headers []string, rows [][]string) error {
+	records := make([]record, 0, len(rows))
+	for _, row := range rows {
+		r := record{keys: headers, values: make([]string, len(headers))}
+		for i := range headers {
+			if i < len(row) {
+				r.values[i] = cleanValue(row[i])
+			}
+		}
+		records = append(records, r)
+	}
+
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, records)
+	case FormatYAML:
+		seq := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, r := range records {
+			seq.Content = append(seq.Content, r.yamlNode())
+		}
+		return writeYAML(w, seq)
+	case FormatCSV, FormatTSV:
+		lines := [][]string{headers}
+		for _, r := range records {
+			lines = append(lines, r.values)
+		}
+		return writeDelimited(w, format, lines)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// WriteSections writes the sections of a detail table to w in the given format.
+// JSON and YAML output an object keyed by section title, CSV and TSV output one
+// "Section, Field, Value" line per field.
+func WriteSections(w io.Writer, format OutputFormat, sections []Section) error {
+	var named []namedRecord
+	for _, s := range sections {
+		r := namedRecord{name: s.Title}
+		for _, f := range s.Fields {
+			r.keys = append(r.keys, f.Name)
+			r.values = append(r.values, cleanValue(f.Value))
+		}
+		named = append(named, r)
+	}
+
+	switch format {
+	case FormatJSON:
+		var buf bytes.Buffer
+		buf.WriteByte('{')
+		for i, r := range named {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			k, err := json.Marshal(r.name)
+			if err != nil {
+				return err
+			}
+			v, err := r.record.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			buf.Write(k)
+			buf.WriteByte(':')
+			buf.Write(v)
+		}
+		buf.WriteByte('}')
+		return writeIndentedJSON(w, buf.Bytes())
+	case FormatYAML:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, r := range named {
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: r.name}, r.yamlNode())
+		}
+		return writeYAML(w, node)
+	case FormatCSV, FormatTSV:
+		lines := [][]string{{"Section", "Field", "Value"}}
+		for _, r := range named {
+			for i, key := range r.keys {
+				lines = append(lines, []string{r.name, key, r.values[i]})
+			}
+		}
+		return writeDelimited(w, format, lines)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// writeJSON writes the records as an indented JSON array.
+func writeJSON(w io.Writer, records []record) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return writeIndentedJSON(w, data)
+}
+
+// writeIndentedJSON indents the provided JSON document and writes it to w.
+func writeIndentedJSON(w io.Writer, data []byte) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeYAML encodes the provided node as a YAML document.
+func writeYAML(w io.Writer, node *yaml.Node) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// writeDelimited writes the lines as comma or tab separated values.
+func writeDelimited(w io.Writer, format OutputFormat, lines [][]string) error {
+	cw := csv.NewWriter(w)
+	if format == FormatTSV {
+		cw.Comma = '\t'
+	}
+	if err := cw.WriteAll(lines); err != nil {
+		return err
+	}
+	return cw.Error()
+}
@@ -0,0 +1,56 @@
+package tablewriter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for WriteRecords with JSON output
+func TestWriteRecordsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	headers := []string{"Name", "State"}
+	rows := [][]string{{"web", text.FgGreen.Sprint("running")}}
+
+	err := WriteRecords(&buf, FormatJSON, headers, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "[\n  {\n    \"Name\": \"web\",\n    \"State\": \"running\"\n  }\n]\n", buf.String())
+}
+
+// Unit test for WriteRecords with CSV and TSV output
+func TestWriteRecordsDelimited(t *testing.T) {
+	headers := []string{"Name", "Tag: Owner"}
+	rows := [][]string{{"web, prod", "ops"}}
+
+	var csvBuf bytes.Buffer
+	assert.NoError(t, WriteRecords(&csvBuf, FormatCSV, headers, rows))
+	assert.Equal(t, "Name,Tag: Owner\n\"web, prod\",ops\n", csvBuf.String())
+
+	var tsvBuf bytes.Buffer
+	assert.NoError(t, WriteRecords(&tsvBuf, FormatTSV, headers, rows))
+	assert.Equal(t, "Name\tTag: Owner\nweb, prod\tops\n", tsvBuf.String())
+}
+
+// Unit test for WriteSections with YAML output
+func TestWriteSectionsYAML(t *testing.T) {
+	var buf bytes.Buffer
+	sections := []Section{
+		{Title: "Instance Details", Fields: []Field{{Name: "Instance ID", Value: "i-123"}}},
+		{Title: "Tags", Fields: []Field{{Name: "Env", Value: "prod"}}},
+	}
+
+	err := WriteSections(&buf, FormatYAML, sections)
+	assert.NoError(t, err)
+	assert.Equal(t, "Instance Details:\n  Instance ID: i-123\nTags:\n  Env: prod\n", buf.String())
+}
+
+// Unit test for SetFormat
+func TestSetFormat(t *testing.T) {
+	defer func() { Format = FormatTable }()
+
+	assert.NoError(t, SetFormat("json"))
+	assert.Equal(t, FormatJSON, Format)
+	assert.Error(t, SetFormat("xml"))
+}
@@ -0,0 +1,216 @@
+package tablewriter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// FilterOperator is the comparison used by a filter expression.
+type FilterOperator string
+
+// FilterOperator constants. Operators are listed longest first so that parsing matches
+// "!=" before "=".
+const (
+	OpNotEqual     FilterOperator = "!="
+	OpNotMatch     FilterOperator = "!~"
+	OpGreaterEqual FilterOperator = ">="
+	OpLessEqual    FilterOperator = "<="
+	OpEqual        FilterOperator = "="
+	OpMatch        FilterOperator = "~"
+	OpGreater      FilterOperator = ">"
+	OpLess         FilterOperator = "<"
+)
+
+var filterOperators = []FilterOperator{
+	OpNotEqual, OpNotMatch, OpGreaterEqual, OpLessEqual, OpEqual, OpMatch, OpGreater, OpLess,
+}
+
+// Filter is a parsed filter expression such as `State=running` or `"Instance Type"~^m5`.
+type Filter struct {
+	// Name is the field name, or the tag key if Tag is true.
+	Name     string
+	Tag      bool
+	Operator FilterOperator
+	Value    string
+
+	pattern *regexp.Regexp
+}
+
+// ParseFilters parses each filter expression. Every filter must match for a row to be kept.
+func ParseFilters(exprs []string) ([]Filter, error) {
+	var filters []Filter
+	for _, expr := range exprs {
+		if strings.TrimSpace(expr) == "" {
+			continue
+		}
+		filter, err := ParseFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// ParseFilter parses a filter expression in the form <field><operator><value>. The field can be
+// quoted to include spaces or operator characters, and tags are given as "Tag:Key".
+// Supported operators are = and != (exact match), ~ and !~ (regular expression), and
+// >, >=, < and <= (typed comparison of numbers, IP addresses and timestamps).
+func ParseFilter(expr string) (Filter, error) {
+	rest := strings.TrimSpace(expr)
+
+	var name string
+	if strings.HasPrefix(rest, `"`) {
+		end := strings.Index(rest[1:], `"`)
+		if end < 0 {
+			return Filter{}, fmt.Errorf("invalid filter %q: unterminated quote", expr)
+		}
+		name, rest = rest[1:end+1], strings.TrimSpace(rest[end+2:])
+	} else {
+		i := strings.IndexAny(rest, "=!~<>")
+		if i < 0 {
+			return Filter{}, fmt.Errorf("invalid filter %q: expected <field><operator><value>, e.g. State=running", expr)
+		}
+		name, rest = rest[:i], rest[i:]
+	}
+
+	filter := Filter{Name: strings.TrimSpace(name)}
+	for _, op := range filterOperators {
+		if strings.HasPrefix(rest, string(op)) {
+			filter.Operator = op
+			rest = rest[len(op):]
+			break
+		}
+	}
+	if filter.Operator == "" {
+		return Filter{}, fmt.Errorf("invalid filter %q: missing operator. Valid operators: =, !=, ~, !~, >, >=, <, <=", expr)
+	}
+	if key, ok := cutTagPrefix(filter.Name); ok {
+		filter.Name, filter.Tag = key, true
+	}
+	if filter.Name == "" {
+		return Filter{}, fmt.Errorf("invalid filter %q: missing field name", expr)
+	}
+
+	filter.Value = unquote(strings.TrimSpace(rest))
+	if filter.Operator == OpMatch || filter.Operator == OpNotMatch {
+		pattern, err := regexp.Compile(filter.Value)
+		if err != nil {
+			return Filter{}, fmt.Errorf("invalid filter %q: %w", expr, err)
+		}
+		filter.pattern = pattern
+	}
+	return filter, nil
+}
+
+// Match reports whether the cell value satisfies the filter. Colours are ignored.
+func (f Filter) Match(value string) bool {
+	value = cleanValue(value)
+	switch f.Operator {
+	case OpEqual:
+		return value == f.Value
+	case OpNotEqual:
+		return value != f.Value
+	case OpMatch:
+		return f.pattern.MatchString(value)
+	case OpNotMatch:
+		return !f.pattern.MatchString(value)
+	}
+
+	c, ok := compareTyped(value, f.Value)
+	if !ok {
+		return false
+	}
+	switch f.Operator {
+	case OpGreater:
+		return c > 0
+	case OpGreaterEqual:
+		return c >= 0
+	case OpLess:
+		return c < 0
+	default:
+		return c <= 0
+	}
+}
+
+// compareTyped compares a cell value to a filter value of the same type. Values with a unit,
+// such as "100 GiB", are compared by their number when the filter value is a number.
+// It returns false if the values cannot be compared.
+func compareTyped(value string, want string) (int, bool) {
+	av, bv := parseValue(value), parseValue(want)
+	if av.kind != bv.kind && bv.kind == kindNumber {
+		if fields := strings.Fields(value); len(fields) > 0 {
+			av = parseValue(fields[0])
+		}
+	}
+	if av.kind != bv.kind || av.kind == kindText {
+		return 0, false
+	}
+	return compareParsed(av, bv), true
+}
+
+// FilterData returns the items that match every filter. Field names are matched
+// case-insensitively against the fields, and an error is returned for fields that cannot be
+// resolved by the getter.
+func FilterData(filters []Filter, fields []Field, data []any, getFieldValue AttributeGetter, getTagValue TagGetter) ([]any, error) {
+	if len(filters) == 0 {
+		return data, nil
+	}
+
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.Name)
+	}
+	for i := range filters {
+		for _, name := range names {
+			if !filters[i].Tag && strings.EqualFold(name, filters[i].Name) {
+				filters[i].Name = name
+				break
+			}
+		}
+	}
+
+	var matched []any
+	for _, item := range data {
+		keep := true
+		for _, filter := range filters {
+			value, err := filterValue(filter, item, getFieldValue, getTagValue)
+			if err != nil && filter.Tag {
+				return nil, fmt.Errorf("filter on tag %q: %w", filter.Name, err)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unknown filter field %q. Available fields: %s", filter.Name, strings.Join(names, ", "))
+			}
+			if !filter.Match(value) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			matched = append(matched, item)
+		}
+	}
+	return matched, nil
+}
+
+// filterValue returns the field or tag value that the filter is evaluated against.
+func filterValue(filter Filter, item any, getFieldValue AttributeGetter, getTagValue TagGetter) (string, error) {
+	if filter.Tag {
+		if getTagValue == nil {
+			return "", fmt.Errorf("tags are not supported by this command")
+		}
+		return getTagValue(filter.Name, item)
+	}
+	return getFieldValue(filter.Name, item)
+}
+
+// unquote removes matching double or single quotes around the value.
+func unquote(value string) string {
+	if len(value) >= 2 {
+		if (value[0] == '"' && value[len(value)-1] == '"') || (value[0] == '\'' && value[len(value)-1] == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
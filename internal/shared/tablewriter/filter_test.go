@@ -0,0 +1,110 @@
+package tablewriter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Unit test for ParseFilter
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected Filter
+	}{
+		{"State=running", Filter{Name: "State", Operator: OpEqual, Value: "running"}},
+		{`"Instance Type"~^m5`, Filter{Name: "Instance Type", Operator: OpMatch, Value: "^m5"}},
+		{"Tag:Env!=prod", Filter{Name: "Env", Tag: true, Operator: OpNotEqual, Value: "prod"}},
+		{`"Allocated Storage">100`, Filter{Name: "Allocated Storage", Operator: OpGreater, Value: "100"}},
+		{"Size >= 8", Filter{Name: "Size", Operator: OpGreaterEqual, Value: "8"}},
+		{`Name="web 1"`, Filter{Name: "Name", Operator: OpEqual, Value: "web 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			filter, err := ParseFilter(tt.expr)
+			require.NoError(t, err)
+			filter.pattern = nil
+			assert.Equal(t, tt.expected, filter)
+		})
+	}
+
+	for _, expr := range []string{"running", "=running", `"State=running`, "Name~[", "State:running"} {
+		_, err := ParseFilter(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+// Unit test for Filter.Match
+func TestFilterMatch(t *testing.T) {
+	tests := []struct {
+		expr  string
+		value string
+		match bool
+	}{
+		{"State=running", text.FgGreen.Sprint("running"), true},
+		{"State=running", "stopped", false},
+		{"State!=running", "stopped", true},
+		{`"Instance Type"~^m5`, "m5.large", true},
+		{`"Instance Type"!~^m5`, "t3.micro", true},
+		{"Storage>100", "200", true},
+		{"Storage>100", "100", false},
+		{"Storage>=100", "100", true},
+		{"Size<10", "8 GiB", true},
+		{"Size<10", "-", false},
+		{`"Private IP"<10.0.1.0`, "10.0.0.25", true},
+		{`"Launch Time">2024-01-01`, "2024-03-01T10:00:00Z", true},
+		{`"Launch Time"<2024-01-01`, "2024-03-01T10:00:00Z", false},
+		{"Name>m", "web", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr+" "+tt.value, func(t *testing.T) {
+			filter, err := ParseFilter(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.match, filter.Match(tt.value))
+		})
+	}
+}
+
+// Unit test for FilterData
+func TestFilterData(t *testing.T) {
+	type item struct {
+		name, state, env string
+	}
+	data := []any{
+		item{"web", "running", "prod"},
+		item{"db", "stopped", "prod"},
+		item{"dev", "running", "dev"},
+	}
+	fields := []Field{{Name: "Name"}, {Name: "State"}}
+	getField := func(name string, instance any) (string, error) {
+		switch name {
+		case "Name":
+			return instance.(item).name, nil
+		case "State":
+			return instance.(item).state, nil
+		}
+		return "", errors.New("unknown field")
+	}
+	getTag := func(key string, instance any) (string, error) {
+		if key == "Env" {
+			return instance.(item).env, nil
+		}
+		return "", nil
+	}
+
+	filters, err := ParseFilters([]string{"state=running", "Tag:Env!=dev"})
+	require.NoError(t, err)
+	matched, err := FilterData(filters, fields, data, getField, getTag)
+	require.NoError(t, err)
+	assert.Equal(t, []any{data[0]}, matched)
+
+	filters, err = ParseFilters([]string{"Owner=me"})
+	require.NoError(t, err)
+	_, err = FilterData(filters, fields, data, getField, getTag)
+	assert.EqualError(t, err, `unknown filter field "Owner". Available fields: Name, State`)
+}
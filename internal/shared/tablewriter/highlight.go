@@ -0,0 +1,102 @@
+package tablewriter
+
+import (
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// Output is where tables and machine-readable records are written. Watch mode replaces it with
+// a buffer so that each frame can be drawn in a single write.
+var Output io.Writer = os.Stdout
+
+// highlightColors is used for cells that changed since the previous render.
+var highlightColors = text.Colors{text.BgYellow, text.FgBlack}
+
+// changes tracks the cell values of each render when highlighting is enabled.
+var changes *changeTracker
+
+// changeTracker records the value of every cell rendered in a frame, and compares it to the
+// value of the same cell in the previous frame. List cells are identified by the value in the
+// first column of their row and their column header, and detail cells by their section and
+// field name, so that changes in sort order do not mark cells as changed.
+type changeTracker struct {
+	previous map[string]string
+	current  map[string]string
+}
+
+// HighlightChanges enables highlighting of cells whose values changed since the previous
+// render. The first render is never highlighted.
+func HighlightChanges() {
+	changes = &changeTracker{current: map[string]string{}}
+}
+
+// NextFrame marks the end of a render, so that the next render is compared against it.
+func NextFrame() {
+	if changes == nil {
+		return
+	}
+	changes.previous, changes.current = changes.current, map[string]string{}
+}
+
+// mark records the cell value and returns it highlighted if it differs from the previous frame.
+// Cells that are new since the previous frame, such as the cells of a new row, are also
+// highlighted.
+func (c *changeTracker) mark(key string, value string) string {
+	clean := cleanValue(value)
+	c.current[key] = clean
+	if c.previous == nil {
+		return value
+	}
+	if prev, ok := c.previous[key]; ok && prev == clean {
+		return value
+	}
+	return highlightColors.Sprint(clean)
+}
+
+// cellKeys builds unique keys for the cells of a single render. Repeated keys, such as two
+// rows with the same first column value, are numbered in the order they are rendered.
+type cellKeys map[string]int
+
+// key returns a unique key for the parts.
+func (k cellKeys) key(parts ...string) string {
+	key := ""
+	for _, part := range parts {
+		key += part + "\x00"
+	}
+	k[key]++
+	return key + strconv.Itoa(k[key])
+}
+
+// highlightRow returns the row values with the changed cells highlighted.
+func (at *AscTable) highlightRow(values []string) []string {
+	if changes == nil || len(values) == 0 {
+		return values
+	}
+	if at.cellKeys == nil {
+		at.cellKeys = cellKeys{}
+	}
+	rowKey := at.cellKeys.key("row", cleanValue(values[0]))
+	highlighted := make([]string, len(values))
+	for i, value := range values {
+		column := strconv.Itoa(i)
+		if i < len(at.headers) {
+			column = at.headers[i]
+		}
+		highlighted[i] = changes.mark(rowKey+"\x00"+column, value)
+	}
+	return highlighted
+}
+
+// highlightField returns the field value, highlighted if it changed.
+func (at *AscTable) highlightField(field Field) string {
+	if changes == nil {
+		return field.Value
+	}
+	if at.cellKeys == nil {
+		at.cellKeys = cellKeys{}
+	}
+	return changes.mark(at.cellKeys.key("field", at.section, field.Name), field.Value)
+}
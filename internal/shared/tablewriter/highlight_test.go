@@ -0,0 +1,56 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for highlightRow comparing rows by their first column
+func TestHighlightRow(t *testing.T) {
+	HighlightChanges()
+	t.Cleanup(func() { changes = nil })
+
+	render := func(rows ...[]string) [][]string {
+		at := &AscTable{headers: []string{"Name", "State"}}
+		var out [][]string
+		for _, row := range rows {
+			out = append(out, at.highlightRow(row))
+		}
+		NextFrame()
+		return out
+	}
+
+	first := render([]string{"web-1", "running"}, []string{"web-2", "pending"})
+	assert.Equal(t, [][]string{{"web-1", "running"}, {"web-2", "pending"}}, first, "first render is never highlighted")
+
+	second := render([]string{"web-2", "running"}, []string{"web-1", "running"}, []string{"web-3", "pending"})
+	assert.Equal(t, []string{"web-2", highlightColors.Sprint("running")}, second[0])
+	assert.Equal(t, []string{"web-1", "running"}, second[1], "reordered rows are not changes")
+	assert.Equal(t, []string{highlightColors.Sprint("web-3"), highlightColors.Sprint("pending")}, second[2], "new rows are highlighted")
+
+	third := render([]string{"web-2", "running"})
+	assert.Equal(t, []string{"web-2", "running"}, third[0], "unchanged cells are no longer highlighted")
+}
+
+// Unit test for highlightField using the section to tell fields apart
+func TestHighlightField(t *testing.T) {
+	HighlightChanges()
+	t.Cleanup(func() { changes = nil })
+
+	render := func(sections map[string]string) map[string]string {
+		at := &AscTable{}
+		out := map[string]string{}
+		for _, section := range []string{"Details", "Tags"} {
+			at.section = section
+			out[section] = at.highlightField(Field{Name: "Name", Value: sections[section]})
+		}
+		NextFrame()
+		return out
+	}
+
+	render(map[string]string{"Details": "db-1", "Tags": "primary"})
+	got := render(map[string]string{"Details": "db-1", "Tags": "replica"})
+	assert.Equal(t, "db-1", got["Details"])
+	assert.Equal(t, highlightColors.Sprint("replica"), got["Tags"])
+}
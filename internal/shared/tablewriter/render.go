@@ -1,12 +1,26 @@
 package tablewriter
 
 import (
+	"fmt"
 	"os"
+	"sync/atomic"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 )
 
+// MaxItems is the maximum number of rows rendered in each list, set by the global --max-items
+// flag. Zero means no limit.
+var MaxItems int
+
+// truncated is set when a list was rendered without some of its rows because of MaxItems.
+var truncated atomic.Bool
+
+// Truncated returns true if any list was cut short by MaxItems.
+func Truncated() bool {
+	return truncated.Load()
+}
+
 // AscWriter is the interface for the AscTable.
 type AscWriter interface {
 	AppendRow(row Row)
@@ -31,6 +45,11 @@ type AscTable struct {
 	renderOptions AscTableRenderOptions
 	sortByFields  []Field
 	style         *string // Lazy style initialization
+	headers       []string
+	rows          [][]string
+	blocks        []rowBlock
+	section       string   // Title of the section being appended, used to highlight changes
+	cellKeys      cellKeys // Keys of the cells appended so far, used to highlight changes
 }
 
 // AscTableRenderOptions is the options for the AscTable.
@@ -42,6 +61,8 @@ type AscTableRenderOptions struct {
 	MinColumnWidth int
 	MaxColumnWidth int
 	MergedColumns  []string
+	SortBy         []Field // Sort order from the --sort flag, overrides the SortBy of each field
+	MaxRows        int     // Maximum number of rows rendered, after sorting. Zero means no limit
 }
 
 // Field is a single field in a row. It contains a name and a value.
@@ -78,7 +99,7 @@ func (at *AscTable) getStyle() string {
 	if at.style == nil {
 		// Lazy initialization - set default if none specified
 		if at.renderOptions.Style == "" {
-			defaultStyle := "rounded"
+			defaultStyle := DefaultStyle
 			at.style = &defaultStyle
 		} else {
 			at.style = &at.renderOptions.Style
@@ -95,38 +116,66 @@ func (at *AscTable) SetRenderStyle(style string) {
 }
 
 // Render writes the table to the console.
+// If a machine-readable output format is set, the header and rows are written as records instead.
 func (at *AscTable) Render() {
-	at.table.SetOutputMirror(os.Stdout)
+	if IsMachineReadable() {
+		sortRows(at.headers, at.rows, at.sortByFields)
+		if err := WriteRecords(Output, Format, at.headers, at.limitRows(at.rows, 0)); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing %s output: %v\n", Format, err)
+		}
+		return
+	}
+
+	at.table.SetOutputMirror(Output)
 	at.table.SetTitle(text.Colors{text.Bold}.Sprint(at.renderOptions.Title))
 	at.table.SetStyle(TableStyles[at.getStyle()])
 	at.SetColumnWidth(at.renderOptions.MinColumnWidth, at.renderOptions.MaxColumnWidth)
 	at.table.SetColumnConfigs(at.renderOptions.ColumnConfigs)
 
-	if len(at.sortByFields) > 0 {
-		sortBy := parseSortBy(at.sortByFields)
-		if len(sortBy) > 0 {
-			at.table.SortBy(sortBy)
+	rendered := 0
+	for _, block := range at.blocks {
+		if block.append != nil {
+			block.append()
+			continue
 		}
+		sortRows(at.headers, block.rows, at.sortByFields)
+		rows := at.limitRows(block.rows, rendered)
+		at.appendTableRows(rows)
+		rendered += len(rows)
 	}
 
 	at.table.Render()
 }
 
+// limitRows returns the rows that fit within the MaxRows render option, given the number of rows
+// already rendered, and records that the list was truncated if any are left out.
+func (at *AscTable) limitRows(rows [][]string, rendered int) [][]string {
+	limit := at.renderOptions.MaxRows
+	if limit <= 0 || rendered+len(rows) <= limit {
+		return rows
+	}
+	truncated.Store(true)
+	return rows[:max(0, limit-rendered)]
+}
+
 // GetColumns returns the number of columns in the table
 func (at *AscTable) GetColumns() int {
 	return at.renderOptions.Columns
 }
 
 // SetFieldConfigs sets the field configurations for the table.
+// The sort order is taken from the SortBy render option if set, otherwise from the fields
+// marked SortBy, falling back to the DefaultSort field.
 func (at *AscTable) SetFieldConfigs(fields []Field, reverse bool) {
 	for _, field := range fields {
 		if field.Merge {
 			at.renderOptions.ColumnConfigs = append(at.renderOptions.ColumnConfigs, table.ColumnConfig{Name: field.Name, AutoMerge: true})
 		}
-		if field.SortBy {
+		if field.SortBy && len(at.renderOptions.SortBy) == 0 {
 			at.sortByFields = append(at.sortByFields, field)
 		}
 	}
+	at.sortByFields = append(at.sortByFields, at.renderOptions.SortBy...)
 	if len(at.sortByFields) == 0 {
 		at.sortByFields = append(at.sortByFields, parseDefaultSort(fields))
 	}
@@ -138,19 +187,6 @@ func (at *AscTable) SetFieldConfigs(fields []Field, reverse bool) {
 	}
 }
 
-// parseSortBy converts fields to table.SortBy
-func parseSortBy(fields []Field) []table.SortBy {
-	var sortBy []table.SortBy
-	for _, field := range fields {
-			sortBy = append(sortBy, table.SortBy{
-				Name:       field.Name,
-				Mode:       table.SortMode(field.SortDirection),
-				IgnoreCase: true,
-		})
-	}
-	return sortBy
-}
-
 // parseDefaultSort sets the DefaultSort field to true if it is not already set.
 func parseDefaultSort(fields []Field) Field {
 	for _, field := range fields {
@@ -161,10 +197,10 @@ func parseDefaultSort(fields []Field) Field {
 	return Field{}
 }
 
-// reverseSortDirection reverses the sort direction.
+// reverseSortDirection reverses the sort direction. Fields without a direction sort ascending.
 func reverseSortDirection(sortDirection SortDirection) SortDirection {
-	if sortDirection == Asc {
-		return Desc
+	if sortDirection == Desc {
+		return Asc
 	}
-	return Asc
+	return Desc
 }
@@ -1,5 +1,10 @@
 package tablewriter
 
+import (
+	"fmt"
+	"strings"
+)
+
 // RenderListOptions contains the configuration for rendering a list table.
 type RenderListOptions struct {
 	Title         string
@@ -7,6 +12,9 @@ type RenderListOptions struct {
 	PlainStyle    bool
 	Fields        []Field
 	Tags          []string
+	Columns       []string
+	Sort          []string
+	Filters       []string
 	Data          []any
 	GetFieldValue AttributeGetter
 	GetTagValue   TagGetter
@@ -35,6 +43,76 @@ func hideEmptyFields(fields []Field, data []any, getFieldValue AttributeGetter)
 	return fields
 }
 
+// SelectFields returns the fields for the requested columns, in the order they were requested.
+// Columns are matched case-insensitively against the field names. Columns prefixed with "Tag:"
+// become tag fields, and any other unknown column is passed through for GetFieldValue to resolve.
+func SelectFields(fields []Field, columns []string) []Field {
+	selected := make([]Field, 0, len(columns))
+	for _, column := range columns {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			continue
+		}
+		if key, ok := cutTagPrefix(column); ok {
+			selected = append(selected, Field{Name: key, Category: "Tags", Visible: true})
+			continue
+		}
+
+		field := Field{Name: column, Visible: true}
+		for _, f := range fields {
+			if strings.EqualFold(f.Name, column) {
+				field = f
+				field.Visible = true
+				break
+			}
+		}
+		selected = append(selected, field)
+	}
+	return selected
+}
+
+// cutTagPrefix returns the tag key if the column is in the form "Tag:Key" or "Tag: Key".
+func cutTagPrefix(column string) (string, bool) {
+	if len(column) < 4 || !strings.EqualFold(column[:4], "tag:") {
+		return "", false
+	}
+	key := strings.TrimSpace(column[4:])
+	return key, key != ""
+}
+
+// validateColumns checks that every selected field that is not defined by the command can be
+// resolved by the field getter. The first data item is used as a sample.
+func validateColumns(defined []Field, selected []Field, data []any, getFieldValue AttributeGetter) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(defined))
+	for _, f := range defined {
+		names = append(names, f.Name)
+	}
+
+	for _, field := range selected {
+		if field.Category == "Tags" || containsFold(names, field.Name) {
+			continue
+		}
+		if _, err := getFieldValue(field.Name, data[0]); err != nil {
+			return fmt.Errorf("unknown column %q. Available columns: %s", field.Name, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// containsFold reports whether the slice contains the value, ignoring case.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
 // SetFieldVisibility sets the visibility of a field by name.
 // It iterates through the fields slice and sets Visible to the specified value
 // for the first field matching the given name.
@@ -49,33 +127,63 @@ func SetFieldVisibility(fields []Field, name string, visible bool) {
 
 // RenderList creates and renders a list-style table with the provided options.
 // This helper consolidates the common pattern used across all list commands:
+//   - Removes the rows that do not match the filters
+//   - Replaces the field list with the requested columns, if any, or otherwise shows the
+//     columns that are sorted by
+//   - Appends tag fields to the field list
+//   - Parses the requested sort order against the header row
 //   - Creates a table with the specified title and style
 //   - Applies plain style if PlainStyle is true
-//   - Appends tag fields to the field list
 //   - Builds and appends header row
 //   - Builds and appends data rows using the provided getters
 //   - Configures field sorting
-//   - Renders the table
-func RenderList(opts RenderListOptions) {
-	table := NewAscWriter(AscTableRenderOptions{
-		Title: opts.Title,
-		Style: opts.Style,
-	})
-
-	if opts.PlainStyle {
-		table.SetRenderStyle("plain")
+//   - Renders the table, up to MaxItems rows after sorting
+func RenderList(opts RenderListOptions) error {
+	filters, err := ParseFilters(opts.Filters)
+	if err != nil {
+		return err
+	}
+	opts.Data, err = FilterData(filters, opts.Fields, opts.Data, opts.GetFieldValue, opts.GetTagValue)
+	if err != nil {
+		return err
 	}
 
 	fields := opts.Fields
-	if opts.HideEmpty {
-		fields = hideEmptyFields(fields, opts.Data, opts.GetFieldValue)
+	if len(opts.Columns) > 0 {
+		fields = SelectFields(opts.Fields, opts.Columns)
+		if err := validateColumns(opts.Fields, fields, opts.Data, opts.GetFieldValue); err != nil {
+			return err
+		}
+	} else {
+		if opts.HideEmpty {
+			fields = hideEmptyFields(fields, opts.Data, opts.GetFieldValue)
+		}
+		fields, opts.Tags = ShowSortColumns(fields, opts.Tags, opts.Sort)
 	}
 	if len(opts.Tags) > 0 {
 		fields = AppendTagFields(fields, opts.Tags, opts.Data)
 	}
 
-	table.AppendHeader(BuildHeaderRow(fields))
+	headers := BuildHeaderRow(fields)
+	sortBy, err := ParseSort(opts.Sort, headers)
+	if err != nil {
+		return err
+	}
+
+	table := NewAscWriter(AscTableRenderOptions{
+		Title:   opts.Title,
+		Style:   opts.Style,
+		SortBy:  sortBy,
+		MaxRows: MaxItems,
+	})
+
+	if opts.PlainStyle {
+		table.SetRenderStyle("plain")
+	}
+
+	table.AppendHeader(headers)
 	table.AppendRows(BuildRows(opts.Data, fields, opts.GetFieldValue, opts.GetTagValue))
 	table.SetFieldConfigs(fields, opts.ReverseSort)
 	table.Render()
+	return nil
 }
@@ -0,0 +1,126 @@
+package tablewriter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for SelectFields
+func TestSelectFields(t *testing.T) {
+	fields := []Field{
+		{Name: "Name", Visible: true, DefaultSort: true},
+		{Name: "Instance ID", Visible: true},
+		{Name: "VPC ID", Visible: false},
+	}
+
+	selected := SelectFields(fields, []string{"vpc id", " Name", "Tag: Owner", "vCPUs"})
+	assert.Equal(t, []Field{
+		{Name: "VPC ID", Visible: true},
+		{Name: "Name", Visible: true, DefaultSort: true},
+		{Name: "Owner", Category: "Tags", Visible: true},
+		{Name: "vCPUs", Visible: true},
+	}, selected)
+}
+
+// Unit test for validateColumns
+func TestValidateColumns(t *testing.T) {
+	defined := []Field{{Name: "Name"}}
+	getter := func(fieldName string, instance any) (string, error) {
+		if fieldName == "vCPUs" {
+			return "2", nil
+		}
+		return "", fmt.Errorf("field %s not found", fieldName)
+	}
+	data := []any{struct{}{}}
+
+	assert.NoError(t, validateColumns(defined, SelectFields(defined, []string{"Name", "vCPUs", "Tag:Owner"}), data, getter))
+	assert.EqualError(t, validateColumns(defined, SelectFields(defined, []string{"Bogus"}), data, getter),
+		`unknown column "Bogus". Available columns: Name`)
+	assert.NoError(t, validateColumns(defined, SelectFields(defined, []string{"Bogus"}), nil, getter))
+}
+
+// Unit test for RenderList capping the rows at MaxItems after filtering and sorting
+func TestRenderListMaxItems(t *testing.T) {
+	defer func() { MaxItems = 0; truncated.Store(false); Format = FormatTable; Output = os.Stdout }()
+	Format = FormatJSON
+
+	render := func(maxItems int, filters []string) []map[string]string {
+		MaxItems = maxItems
+		var buf bytes.Buffer
+		Output = &buf
+		err := RenderList(RenderListOptions{
+			Fields:  []Field{{Name: "Name", Visible: true}, {Name: "Size", Visible: true}},
+			Sort:    []string{"Size:desc"},
+			Filters: filters,
+			Data:    []any{"a", "bb", "cccc", "ddd", "eeeee"},
+			GetFieldValue: func(fieldName string, instance any) (string, error) {
+				if fieldName == "Size" {
+					return fmt.Sprint(len(instance.(string))), nil
+				}
+				return instance.(string), nil
+			},
+		})
+		assert.NoError(t, err)
+		var records []map[string]string
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &records))
+		return records
+	}
+
+	assert.Len(t, render(0, nil), 5)
+	assert.False(t, Truncated())
+
+	records := render(2, nil)
+	assert.Equal(t, []map[string]string{{"Name": "eeeee", "Size": "5"}, {"Name": "cccc", "Size": "4"}}, records)
+	assert.True(t, Truncated())
+
+	truncated.Store(false)
+	assert.Len(t, render(2, []string{"Size<3"}), 2)
+	assert.False(t, Truncated(), "exactly MaxItems rows is not truncated")
+}
+
+// Unit test for RenderList sorting by columns that are hidden by default or because they are empty
+func TestRenderListSortHidden(t *testing.T) {
+	defer func() { Format = FormatTable; Output = os.Stdout }()
+	Format = FormatJSON
+
+	render := func(opts RenderListOptions) []map[string]string {
+		var buf bytes.Buffer
+		Output = &buf
+		opts.Data = []any{"bb", "a", "ccc"}
+		opts.GetFieldValue = func(fieldName string, instance any) (string, error) {
+			switch fieldName {
+			case "Size":
+				return fmt.Sprint(len(instance.(string))), nil
+			case "Note":
+				return "", nil
+			}
+			return instance.(string), nil
+		}
+		assert.NoError(t, RenderList(opts))
+		var records []map[string]string
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &records))
+		return records
+	}
+
+	records := render(RenderListOptions{
+		Fields: []Field{{Name: "Name", Visible: true}, {Name: "Size", Visible: false}},
+		Sort:   []string{"Size:desc"},
+	})
+	assert.Equal(t, []map[string]string{
+		{"Name": "ccc", "Size": "3"}, {"Name": "bb", "Size": "2"}, {"Name": "a", "Size": "1"},
+	}, records)
+
+	records = render(RenderListOptions{
+		Fields:    []Field{{Name: "Name", Visible: true}, {Name: "Note", Visible: true}},
+		Sort:      []string{"Note", "Name"},
+		HideEmpty: true,
+	})
+	assert.Equal(t, []map[string]string{
+		{"Name": "a", "Note": ""}, {"Name": "bb", "Note": ""}, {"Name": "ccc", "Note": ""},
+	}, records)
+}
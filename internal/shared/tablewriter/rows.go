@@ -21,13 +21,39 @@ type HorizontalRow struct {
 	Field Field
 }
 
+// rowBlock is a group of rows added to the table when it is rendered. A block either holds
+// standard rows, which are sorted together, or a function that appends any other row type.
+type rowBlock struct {
+	rows   [][]string
+	append func()
+}
+
 // AppendRow creates a standard row with the provided values.
+// Consecutive standard rows are sorted together when the table is rendered.
 func (at *AscTable) AppendRow(row Row) {
-	rowValues := make(table.Row, len(row.Values))
-	for i := 0; i < len(row.Values); i++ {
-		rowValues[i] = text.Colors{}.Sprint(row.Values[i])
+	if n := len(at.blocks); n == 0 || at.blocks[n-1].append != nil {
+		at.blocks = append(at.blocks, rowBlock{})
+	}
+	block := &at.blocks[len(at.blocks)-1]
+	block.rows = append(block.rows, row.Values)
+	at.rows = append(at.rows, row.Values)
+}
+
+// appendBlock defers a function that appends rows to the table until it is rendered.
+func (at *AscTable) appendBlock(fn func()) {
+	at.blocks = append(at.blocks, rowBlock{append: fn})
+}
+
+// appendTableRows appends standard rows to the underlying table.
+func (at *AscTable) appendTableRows(rows [][]string) {
+	for _, values := range rows {
+		values = at.highlightRow(values)
+		rowValues := make(table.Row, len(values))
+		for i := 0; i < len(values); i++ {
+			rowValues[i] = text.Colors{}.Sprint(values[i])
+		}
+		at.table.AppendRow(rowValues)
 	}
-	at.table.AppendRow(rowValues)
 }
 
 // AppendRows creates a new row for each of the provided rows.
@@ -48,6 +74,7 @@ func (at *AscTable) AppendHeader(headers []string) {
 		headerRow[i] = header
 	}
 	at.table.AppendHeader(headerRow)
+	at.headers = headers
 }
 
 // AppendGridRow creates a new grid row with the provided fields and values.
@@ -58,13 +85,18 @@ func (at *AscTable) AppendHeader(headers []string) {
 //	│ John                  │ Doe                     │ 30                    │
 //	├───────────────────────┼─────────────────────────┼───────────────────────┤
 func (at *AscTable) AppendGridRow(ar GridRow) {
+	at.appendBlock(func() { at.appendGridRow(ar) })
+}
+
+// appendGridRow appends a grid row to the underlying table.
+func (at *AscTable) appendGridRow(ar GridRow) {
 	nr := make(table.Row, at.renderOptions.Columns)
 	vr := make(table.Row, at.renderOptions.Columns)
 
 	for i := 0; i < at.renderOptions.Columns; i++ {
 		if i < len(ar.Fields) {
 			nr[i] = text.Colors{text.Bold, text.FgBlue}.Sprint(ar.Fields[i].Name)
-			vr[i] = ar.Fields[i].Value
+			vr[i] = at.highlightField(ar.Fields[i])
 		} else {
 			nr[i] = ""
 			vr[i] = ""
@@ -89,6 +121,12 @@ func (at *AscTable) AppendGridRows(ar []GridRow) {
 //	│ Title                                                                   │
 //	╰─────────────────────────────────────────────────────────────────────────╯
 func (at *AscTable) AppendTitleRow(title string) {
+	at.appendBlock(func() { at.appendTitleRow(title) })
+}
+
+// appendTitleRow appends a title row to the underlying table.
+func (at *AscTable) appendTitleRow(title string) {
+	at.section = title
 	row := make(table.Row, at.renderOptions.Columns)
 	for i := 0; i < at.renderOptions.Columns; i++ {
 		row[i] = text.Colors{text.Bold}.Sprint(title)
@@ -106,10 +144,16 @@ func (at *AscTable) AppendTitleRow(title string) {
 //	│ Name                  │ John Doe                                        │
 //	╰───────────────────────┴─────────────────────────────────────────────────╯
 func (at *AscTable) AppendHorizontalRow(hr HorizontalRow) {
+	at.appendBlock(func() { at.appendHorizontalRow(hr) })
+}
+
+// appendHorizontalRow appends a horizontal row to the underlying table.
+func (at *AscTable) appendHorizontalRow(hr HorizontalRow) {
 	row := make(table.Row, at.renderOptions.Columns)
 	row[0] = text.Colors{text.Bold, text.FgBlue}.Sprint(hr.Field.Name)
+	value := at.highlightField(hr.Field)
 	for i := 1; i < at.renderOptions.Columns; i++ {
-		row[i] = text.Colors{}.Sprint(hr.Field.Value)
+		row[i] = text.Colors{}.Sprint(value)
 	}
 	at.table.AppendRow(row, table.RowConfig{AutoMerge: true, AutoMergeAlign: text.AlignLeft})
 }
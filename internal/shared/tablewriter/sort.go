@@ -0,0 +1,199 @@
+package tablewriter
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts are the layouts used to format timestamps across the services.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05 MST",
+	time.DateTime,
+	time.DateOnly,
+}
+
+// valueKind is the detected type of a cell value. Kinds are ordered so that numbers sort
+// before IP addresses, then timestamps, then text.
+type valueKind int
+
+const (
+	kindNumber valueKind = iota
+	kindIP
+	kindTime
+	kindText
+)
+
+// typedValue is a cell value parsed into its detected type.
+type typedValue struct {
+	kind   valueKind
+	number float64
+	ip     netip.Prefix
+	time   time.Time
+	text   string
+}
+
+// ParseSort parses a sort specification such as "Launch Time:desc,Name" into sort fields.
+// Each column is matched case-insensitively against the headers, and tag columns can be given
+// as "Tag:Key". The direction is optional and defaults to ascending.
+func ParseSort(spec []string, headers []string) ([]Field, error) {
+	var sortBy []Field
+	for _, entry := range spec {
+		name, direction := splitSortEntry(entry)
+		if name == "" {
+			continue
+		}
+		if key, ok := cutTagPrefix(name); ok {
+			name = fmt.Sprintf("Tag: %s", key)
+		}
+
+		index := slices.IndexFunc(headers, func(header string) bool {
+			return strings.EqualFold(header, name)
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("cannot sort by %q: column is not displayed. Sortable columns: %s", name, strings.Join(headers, ", "))
+		}
+		sortBy = append(sortBy, Field{Name: headers[index], SortBy: true, SortDirection: direction})
+	}
+	return sortBy, nil
+}
+
+// ShowSortColumns makes the columns named in a sort specification visible, so that a list can
+// be sorted by a field that is hidden by default, such as Launch Time, or hidden because it is
+// empty. Tag columns that are sorted by are added to tags. Columns that are not fields are left
+// for ParseSort to report.
+func ShowSortColumns(fields []Field, tags []string, spec []string) ([]Field, []string) {
+	for _, entry := range spec {
+		name, _ := splitSortEntry(entry)
+		if key, ok := cutTagPrefix(name); ok {
+			if !containsFold(tags, key) {
+				tags = append(tags, key)
+			}
+			continue
+		}
+		for i := range fields {
+			if strings.EqualFold(fields[i].Name, name) {
+				fields[i].Visible = true
+			}
+		}
+	}
+	return fields, tags
+}
+
+// splitSortEntry splits an entry of a sort specification, such as "Launch Time:desc", into the
+// column name and the sort direction.
+func splitSortEntry(entry string) (string, SortDirection) {
+	entry = strings.TrimSpace(entry)
+	name, direction := entry, Asc
+	if i := strings.LastIndex(entry, ":"); i >= 0 {
+		switch strings.ToLower(strings.TrimSpace(entry[i+1:])) {
+		case "asc":
+			name = entry[:i]
+		case "desc":
+			name, direction = entry[:i], Desc
+		}
+	}
+	return strings.TrimSpace(name), direction
+}
+
+// sortRows sorts the rows in place using the provided sort fields. Values are compared by
+// their detected type, so numbers, IP addresses and timestamps sort naturally.
+// Fields that do not match a header are ignored.
+func sortRows(headers []string, rows [][]string, sortBy []Field) {
+	type sortKey struct {
+		index     int
+		direction SortDirection
+	}
+
+	var keys []sortKey
+	for _, field := range sortBy {
+		index := slices.Index(headers, field.Name)
+		if index < 0 {
+			continue
+		}
+		keys = append(keys, sortKey{index: index, direction: field.SortDirection})
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	slices.SortStableFunc(rows, func(a, b []string) int {
+		for _, key := range keys {
+			c := compareValues(valueAt(a, key.index), valueAt(b, key.index))
+			if key.direction == Desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// compareValues compares two cell values by their detected type. Values of different types
+// are ordered by kind, and text is compared case-insensitively.
+func compareValues(a, b string) int {
+	return compareParsed(parseValue(a), parseValue(b))
+}
+
+// compareParsed compares two parsed cell values, ordering values of different types by kind.
+func compareParsed(av, bv typedValue) int {
+	if av.kind != bv.kind {
+		return cmp.Compare(av.kind, bv.kind)
+	}
+
+	switch av.kind {
+	case kindNumber:
+		return cmp.Compare(av.number, bv.number)
+	case kindIP:
+		if c := av.ip.Addr().Compare(bv.ip.Addr()); c != 0 {
+			return c
+		}
+		return cmp.Compare(av.ip.Bits(), bv.ip.Bits())
+	case kindTime:
+		return av.time.Compare(bv.time)
+	default:
+		return strings.Compare(av.text, bv.text)
+	}
+}
+
+// parseValue detects the type of a cell value, ignoring colours and surrounding whitespace.
+// Numbers followed by a unit, such as "100 GiB", are parsed as their number.
+func parseValue(value string) typedValue {
+	value = strings.TrimSpace(cleanValue(value))
+
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return typedValue{kind: kindNumber, number: n}
+	}
+	if number, unit, ok := strings.Cut(value, " "); ok && !strings.Contains(unit, " ") {
+		if n, err := strconv.ParseFloat(number, 64); err == nil {
+			return typedValue{kind: kindNumber, number: n}
+		}
+	}
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return typedValue{kind: kindIP, ip: netip.PrefixFrom(addr, addr.BitLen())}
+	}
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		return typedValue{kind: kindIP, ip: prefix}
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return typedValue{kind: kindTime, time: t}
+		}
+	}
+	return typedValue{kind: kindText, text: strings.ToLower(value)}
+}
+
+// valueAt returns the value at index i, or "" if the row is too short.
+func valueAt(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for ParseSort
+func TestParseSort(t *testing.T) {
+	headers := []string{"Name", "Launch Time", "Tag: Owner"}
+
+	sortBy, err := ParseSort([]string{"launch time:desc", "Name", "Tag:Owner:asc"}, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, []Field{
+		{Name: "Launch Time", SortBy: true, SortDirection: Desc},
+		{Name: "Name", SortBy: true, SortDirection: Asc},
+		{Name: "Tag: Owner", SortBy: true, SortDirection: Asc},
+	}, sortBy)
+
+	_, err = ParseSort([]string{"State"}, headers)
+	assert.Error(t, err)
+}
+
+// Unit test for ShowSortColumns
+func TestShowSortColumns(t *testing.T) {
+	fields := []Field{
+		{Name: "Name", Visible: true},
+		{Name: "Launch Time", Visible: false},
+		{Name: "Subnet ID", Visible: false},
+	}
+
+	fields, tags := ShowSortColumns(fields, []string{"Env"}, []string{"launch time:desc", "Tag:Owner", "Tag:env", "Bogus"})
+	assert.Equal(t, []Field{
+		{Name: "Name", Visible: true},
+		{Name: "Launch Time", Visible: true},
+		{Name: "Subnet ID", Visible: false},
+	}, fields)
+	assert.Equal(t, []string{"Env", "Owner"}, tags)
+}
+
+// Unit test for sortRows with typed values
+func TestSortRows(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected []string
+	}{
+		{"numbers", []string{"10", "9", "100"}, []string{"9", "10", "100"}},
+		{"numbers with a unit", []string{"100 GiB", "8 GiB", "16 GiB"}, []string{"8 GiB", "16 GiB", "100 GiB"}},
+		{"ip addresses", []string{"10.0.0.10", "10.0.0.9", "192.168.0.1"}, []string{"10.0.0.9", "10.0.0.10", "192.168.0.1"}},
+		{"cidr blocks", []string{"10.0.0.0/16", "10.0.0.0/8", "172.16.0.0/12"}, []string{"10.0.0.0/8", "10.0.0.0/16", "172.16.0.0/12"}},
+		{"timestamps", []string{"2024-03-01T10:00:00Z", "2023-12-31T23:59:59Z", "2024-01-15T08:30:00Z"}, []string{"2023-12-31T23:59:59Z", "2024-01-15T08:30:00Z", "2024-03-01T10:00:00Z"}},
+		{"text", []string{"web", "API", "db"}, []string{"API", "db", "web"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := make([][]string, len(tt.values))
+			for i, v := range tt.values {
+				rows[i] = []string{v}
+			}
+			sortRows([]string{"Value"}, rows, []Field{{Name: "Value", SortDirection: Asc}})
+
+			var got []string
+			for _, row := range rows {
+				got = append(got, row[0])
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+// Unit test for sortRows with multiple keys
+func TestSortRowsMultipleKeys(t *testing.T) {
+	rows := [][]string{{"b", "1"}, {"a", "1"}, {"c", "2"}}
+	sortRows([]string{"Name", "Count"}, rows, []Field{
+		{Name: "Count", SortDirection: Desc},
+		{Name: "Name", SortDirection: Asc},
+	})
+	assert.Equal(t, [][]string{{"c", "2"}, {"a", "1"}, {"b", "1"}}, rows)
+}
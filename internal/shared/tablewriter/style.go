@@ -1,17 +1,37 @@
 package tablewriter
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 )
 
+// DefaultStyle is the style used by tables that do not set one.
+var DefaultStyle = "rounded"
+
 var TableStyles = map[string]table.Style{
 	"rounded":   StyleRounded,
 	"plain":     StylePlain,
 	"rounded-separated": StyleRoundedSeparated,
 }
 
-// SetStyle sets the style of the table.
+// SetDefaultStyle validates and sets the style used by tables that do not set one.
+func SetDefaultStyle(style string) error {
+	if _, ok := TableStyles[style]; !ok {
+		var styles []string
+		for name := range TableStyles {
+			styles = append(styles, name)
+		}
+		slices.Sort(styles)
+		return fmt.Errorf("invalid table style: %s. Valid options: %s", style, strings.Join(styles, ", "))
+	}
+	DefaultStyle = style
+	return nil
+}
+
 // SetStyle sets the style of the table
 func (at *AscTable) SetStyle(style string) {
 	switch style {
@@ -1,18 +1,19 @@
 package main
 
 import (
-	"fmt"
 	"log"
 	"os"
 
 	"github.com/harleymckenzie/asc/cmd"
+	"github.com/harleymckenzie/asc/internal/shared/cmdutil"
 )
 
 func main() {
 	log.SetFlags(0)
 
 	if err := cmd.Execute(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		err = cmdutil.DefaultErrorHandler(err)
+		cmdutil.PrintError(os.Stderr, err)
+		os.Exit(cmdutil.ExitCode(err))
 	}
 }